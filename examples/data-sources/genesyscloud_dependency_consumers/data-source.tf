@@ -0,0 +1,5 @@
+data "genesyscloud_dependency_consumers" "sales_skill" {
+  object_id     = genesyscloud_routing_skill.sales.id
+  resource_type = "genesyscloud_routing_skill"
+  flow_filter   = "published"
+}
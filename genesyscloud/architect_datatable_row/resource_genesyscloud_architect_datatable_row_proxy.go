@@ -6,6 +6,7 @@ import (
 	"errors"
 	"log"
 	"net/http"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 
 	"github.com/mitchellh/mapstructure"
@@ -58,10 +59,10 @@ func newArchitectDatatableRowProxy(clientConfig *platformclientv2.Configuration)
 }
 
 func getArchitectDatatableRowProxy(clientConfig *platformclientv2.Configuration) *architectDatatableRowProxy {
-	if internalProxy == nil {
-		internalProxy = newArchitectDatatableRowProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newArchitectDatatableRowProxy)
 }
 
 func (p *architectDatatableRowProxy) getArchitectDatatable(ctx context.Context, id string, expanded string) (*Datatable, *platformclientv2.APIResponse, error) {
@@ -3,6 +3,7 @@ package architect_emergencygroup
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
 )
@@ -42,11 +43,10 @@ func newArchitectEmergencyGroupProxy(clientConfig *platformclientv2.Configuratio
 }
 
 func getArchitectEmergencyGroupProxy(clientConfig *platformclientv2.Configuration) *architectEmergencyGroupProxy {
-	if internalProxy == nil {
-		internalProxy = newArchitectEmergencyGroupProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return provider.GetProxy(clientConfig, newArchitectEmergencyGroupProxy)
 }
 
 func (p *architectEmergencyGroupProxy) getAllArchitectEmergencyGroups(ctx context.Context) (*[]platformclientv2.Emergencygroup, *platformclientv2.APIResponse, error) {
@@ -1,9 +1,15 @@
 package architect_flow
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+	"os"
+	"path"
 	"sync"
+	"terraform-provider-genesyscloud/genesyscloud/util/recorder"
+	"terraform-provider-genesyscloud/genesyscloud/util/testrunner"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // providerDataSources holds a map of all registered data sources
@@ -50,6 +56,17 @@ func TestMain(m *testing.M) {
 	// Run setup function before starting the test suite for architect_flow package
 	initTestResources()
 
+	// Record or replay the API interactions of the test suite when GENESYSCLOUD_RECORDER_MODE is set
+	rec, err := recorder.Start(path.Join("../", testrunner.GetTestDataPath("cassettes", "architect_flow.json")))
+	if err != nil {
+		log.Fatalf("Failed to start recorder: %v", err)
+	}
+
 	// Run the test suite for the architect_flow package
-	m.Run()
+	code := m.Run()
+
+	if err := rec.Stop(); err != nil {
+		log.Fatalf("Failed to stop recorder: %v", err)
+	}
+	os.Exit(code)
 }
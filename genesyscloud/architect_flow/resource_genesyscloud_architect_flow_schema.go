@@ -22,8 +22,9 @@ func SetRegistrar(l registrar.Registrar) {
 
 func ArchitectFlowExporter() *resourceExporter.ResourceExporter {
 	return &resourceExporter.ResourceExporter{
-		GetResourcesFunc: provider.GetAllWithPooledClient(getAllFlows),
-		RefAttrs:         map[string]*resourceExporter.RefAttrSettings{},
+		GetResourcesFunc:    provider.GetAllWithPooledClient(getAllFlows),
+		LookupReferenceFunc: provider.LookupReferenceWithPooledClient(lookupFlowReference),
+		RefAttrs:            map[string]*resourceExporter.RefAttrSettings{},
 		UnResolvableAttributes: map[string]*schema.Schema{
 			"filepath": ResourceArchitectFlow().Schema["filepath"],
 		},
@@ -43,11 +43,26 @@ func getAllFlows(ctx context.Context, clientConfig *platformclientv2.Configurati
 
 		//This is our go forward naming standard for flows.
 		resources[*flow.Id] = &resourceExporter.ResourceMeta{Name: *flow.VarType + "_" + *flow.Name}
+		if flow.PublishedVersion != nil && flow.PublishedVersion.Id != nil {
+			resources[*flow.Id].Version = *flow.PublishedVersion.Id
+		}
 	}
 
 	return resources, nil
 }
 
+// lookupFlowReference looks up a flow referenced by another resource. The kind of a flow is its type, e.g. INBOUNDCALL.
+func lookupFlowReference(ctx context.Context, clientConfig *platformclientv2.Configuration, id string) (string, bool, diag.Diagnostics) {
+	flow, resp, err := getArchitectFlowProxy(clientConfig).GetFlow(ctx, id)
+	if err != nil {
+		if util.IsStatus404(resp) {
+			return "", false, nil
+		}
+		return "", false, util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("failed to get flow %s: %v", id, err), resp)
+	}
+	return *flow.VarType, true, nil
+}
+
 func readFlow(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
@@ -56,14 +57,13 @@ func newArchitectGrammarProxy(clientConfig *platformclientv2.Configuration) *arc
 	}
 }
 
-// getArchitectGrammarProxy acts as a singleton for the internalProxy. It also ensures
+// getArchitectGrammarProxy returns the proxy of the provider instance that owns the client config. It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getArchitectGrammarProxy(clientConfig *platformclientv2.Configuration) *architectGrammarProxy {
-	if internalProxy == nil {
-		internalProxy = newArchitectGrammarProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return provider.GetProxy(clientConfig, newArchitectGrammarProxy)
 }
 
 // createArchitectGrammar creates a Genesys Cloud Architect Grammar
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 	"terraform-provider-genesyscloud/genesyscloud/util/files"
 	"time"
@@ -62,14 +63,13 @@ func newArchitectGrammarLanguageProxy(clientConfig *platformclientv2.Configurati
 	}
 }
 
-// getArchitectGrammarLanguageProxy acts as a singleton for the internalProxy. It also ensures
+// getArchitectGrammarLanguageProxy returns the proxy of the provider instance that owns the client config. It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getArchitectGrammarLanguageProxy(clientConfig *platformclientv2.Configuration) *architectGrammarLanguageProxy {
-	if internalProxy == nil {
-		internalProxy = newArchitectGrammarLanguageProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return provider.GetProxy(clientConfig, newArchitectGrammarLanguageProxy)
 }
 
 // createArchitectGrammarLanguage creates a Genesys Cloud Architect Grammar Language
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	utillists "terraform-provider-genesyscloud/genesyscloud/util/lists"
 	"time"
 
@@ -81,13 +82,13 @@ func newArchitectIvrProxy(clientConfig *platformclientv2.Configuration) *archite
 	}
 }
 
-// getArchitectIvrProxy acts as a singleton to for the internalProxy.  It also ensures
+// getArchitectIvrProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getArchitectIvrProxy(clientConfig *platformclientv2.Configuration) *architectIvrProxy {
-	if internalProxy == nil {
-		internalProxy = newArchitectIvrProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newArchitectIvrProxy)
 }
 
 // getAllArchitectIvrs retrieves all Genesys Cloud Architect IVRs
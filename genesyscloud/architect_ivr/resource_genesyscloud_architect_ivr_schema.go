@@ -26,9 +26,9 @@ func ArchitectIvrExporter() *resourceExporter.ResourceExporter {
 	return &resourceExporter.ResourceExporter{
 		GetResourcesFunc: provider.GetAllWithPooledClient(getAllIvrConfigs),
 		RefAttrs: map[string]*resourceExporter.RefAttrSettings{
-			"open_hours_flow_id":    {RefType: "genesyscloud_flow"},
-			"closed_hours_flow_id":  {RefType: "genesyscloud_flow"},
-			"holiday_hours_flow_id": {RefType: "genesyscloud_flow"},
+			"open_hours_flow_id":    {RefType: "genesyscloud_flow", RefKinds: []string{"INBOUNDCALL"}},
+			"closed_hours_flow_id":  {RefType: "genesyscloud_flow", RefKinds: []string{"INBOUNDCALL"}},
+			"holiday_hours_flow_id": {RefType: "genesyscloud_flow", RefKinds: []string{"INBOUNDCALL"}},
 			"schedule_group_id":     {RefType: "genesyscloud_architect_schedulegroups"},
 			"division_id":           {RefType: "genesyscloud_auth_division"},
 		},
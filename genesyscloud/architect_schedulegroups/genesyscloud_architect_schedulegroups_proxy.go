@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
 )
@@ -52,13 +53,13 @@ func newArchitectSchedulegroupsProxy(clientConfig *platformclientv2.Configuratio
 	}
 }
 
-// getArchitectSchedulegroupsProxy acts as a singleton to for the internalProxy.  It also ensures
+// getArchitectSchedulegroupsProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getArchitectSchedulegroupsProxy(clientConfig *platformclientv2.Configuration) *architectSchedulegroupsProxy {
-	if internalProxy == nil {
-		internalProxy = newArchitectSchedulegroupsProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newArchitectSchedulegroupsProxy)
 }
 
 // createArchitectSchedulegroups creates a Genesys Cloud architect schedulegroups
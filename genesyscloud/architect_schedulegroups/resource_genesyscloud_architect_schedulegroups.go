@@ -33,7 +33,7 @@ func getAllAuthArchitectSchedulegroups(ctx context.Context, clientConfig *platfo
 	}
 
 	for _, scheduleGroup := range *scheduleGroups {
-		resources[*scheduleGroup.Id] = &resourceExporter.ResourceMeta{Name: *scheduleGroup.Name}
+		resources[*scheduleGroup.Id] = &resourceExporter.ResourceMeta{Name: *scheduleGroup.Name, DateModified: scheduleGroup.DateModified}
 	}
 
 	return resources, nil
@@ -143,7 +143,7 @@ func deleteArchitectSchedulegroups(ctx context.Context, d *schema.ResourceData,
 
 	// DEVTOOLING-313: a schedule group linked to an IVR will not be able to be deleted until that IVR is deleted. Retrying here to make sure it is cleared properly.
 	log.Printf("Deleting schedule group %s", d.Id())
-	diagErr := util.RetryWhen(util.IsStatus409, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+	diagErr := util.RetryWhen(ctx, util.IsStatus409, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 		log.Printf("Deleting schedule group %s", d.Id())
 		proxyResponse, err := proxy.deleteArchitectSchedulegroups(ctx, d.Id())
 		if err != nil {
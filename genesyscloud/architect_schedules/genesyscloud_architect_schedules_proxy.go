@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
@@ -71,17 +72,17 @@ func newArchitectSchedulesProxy(clientConfig *platformclientv2.Configuration) *a
 }
 
 /*
-The function getArchitectSchedulesProxy serves a dual purpose: first, it functions as a singleton for
-the internalProxy, meaning it ensures that only one instance of the internalProxy exists. Second,
+The function getArchitectSchedulesProxy serves a dual purpose: first, it returns the proxy of
+the provider instance that owns the client config, so every org has its own proxy. Second,
 it enables us to proxy our tests by allowing us to directly set the internalProxy package variable.
 This ensures consistency and control in managing the internalProxy across our codebase, while also
 facilitating efficient testing by providing a straightforward way to substitute the proxy for testing purposes.
 */
 func getArchitectSchedulesProxy(clientConfig *platformclientv2.Configuration) *architectSchedulesProxy {
-	if internalProxy == nil {
-		internalProxy = newArchitectSchedulesProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newArchitectSchedulesProxy)
 }
 
 // createArchitectSchedules creates a Genesys Cloud architect schedules
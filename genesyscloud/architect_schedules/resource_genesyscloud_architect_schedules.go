@@ -34,7 +34,7 @@ func getAllArchitectSchedules(ctx context.Context, clientConfig *platformclientv
 	}
 
 	for _, schedule := range *schedules {
-		resources[*schedule.Id] = &resourceExporter.ResourceMeta{Name: *schedule.Name}
+		resources[*schedule.Id] = &resourceExporter.ResourceMeta{Name: *schedule.Name, DateModified: schedule.DateModified}
 	}
 
 	return resources, nil
@@ -164,7 +164,7 @@ func updateArchitectSchedules(ctx context.Context, d *schema.ResourceData, meta
 		return diag.Errorf("Failed to parse date %s: %s", end, err)
 	}
 
-	diagErr := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+	diagErr := util.RetryWhen(ctx, util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 		// Get current schedule version
 		scheduleResponse, proxyResponse, err := proxy.getArchitectSchedulesById(ctx, d.Id())
 
@@ -207,7 +207,7 @@ func deleteArchitectSchedules(ctx context.Context, d *schema.ResourceData, meta
 
 	// DEVTOOLING-311: a schedule linked to a schedule group will not be able to be deleted until that schedule group is deleted. Retryig here to make sure it is cleared properly.
 	log.Printf("Deleting schedule %s", d.Id())
-	diagErr := util.RetryWhen(util.IsStatus409, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+	diagErr := util.RetryWhen(ctx, util.IsStatus409, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 		log.Printf("Deleting schedule %s", d.Id())
 		proxyDelResponse, err := proxy.deleteArchitectSchedules(ctx, d.Id())
 		if err != nil {
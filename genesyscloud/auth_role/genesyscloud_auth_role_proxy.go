@@ -3,6 +3,7 @@ package auth_role
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 
@@ -65,13 +66,13 @@ func newAuthRoleProxy(clientConfig *platformclientv2.Configuration) *authRolePro
 	}
 }
 
-// getAuthRoleProxy acts as a singleton to for the internalProxy.  It also ensures
+// getAuthRoleProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getAuthRoleProxy(clientConfig *platformclientv2.Configuration) *authRoleProxy {
-	if internalProxy == nil {
-		internalProxy = newAuthRoleProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newAuthRoleProxy)
 }
 
 // createAuthRole creates a Genesys Cloud auth role
@@ -3,6 +3,7 @@ package authorization_product
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
 )
@@ -36,13 +37,13 @@ func newauthProductProxy(clientConfig *platformclientv2.Configuration) *authProd
 	}
 }
 
-// getauthProductProxy acts as a singleton to for the internalProxy.  It also ensures
+// getauthProductProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getauthProductProxy(clientConfig *platformclientv2.Configuration) *authProductProxy {
-	if internalProxy == nil {
-		internalProxy = newauthProductProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newauthProductProxy)
 }
 
 // getAuthorizationProduct returns a single Genesys Cloud authorization product by a name
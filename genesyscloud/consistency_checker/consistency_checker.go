@@ -7,6 +7,7 @@ import (
 	"log"
 	"os"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -39,6 +40,8 @@ type ConsistencyCheck struct {
 	checks         int
 	maxStateChecks int
 	resourceType   string
+	// Mismatches already written to the report file, by attribute and actual value
+	reported map[string]bool
 }
 
 type consistencyError struct {
@@ -230,6 +233,52 @@ func (c *ConsistencyCheck) CheckState(currentState *schema.ResourceData) *retry.
 		log.Printf("%s is not set, consistency checker behaving as default", featureToggles.CCToggleName())
 	}
 
+	mismatches := c.findMismatches(currentState)
+	if len(mismatches) == 0 {
+		DeleteConsistencyCheck(currentState.Id())
+		return nil
+	}
+
+	warnOnly := featureToggles.CCWarnToggleExists()
+	bypass := featureToggles.CCToggleExists() && c.checks >= c.maxStateChecks
+	if reportFile, ok := featureToggles.CCReportFile(); ok {
+		outcome := outcomeRetry
+		if warnOnly {
+			outcome = outcomeWarning
+		} else if bypass {
+			outcome = outcomeBypassed
+		}
+		c.reportMismatches(reportFile, currentState, mismatches, outcome)
+	}
+
+	err := retry.RetryableError(mismatches[0].err)
+	if warnOnly {
+		for _, mismatch := range mismatches {
+			log.Printf("[WARN] %s is set, not retrying %s %s: %v", featureToggles.CCWarnToggleName(), c.resourceType, currentState.Id(), mismatch.err)
+		}
+		DeleteConsistencyCheck(currentState.Id())
+		return nil
+	}
+
+	if bypass {
+		c.writeConsistencyErrorToFile(currentState, err)
+		return nil
+	}
+
+	c.checks++
+	return err
+}
+
+// consistencyMismatch is an attribute whose value read from the API does not match the value that was applied
+type consistencyMismatch struct {
+	err *consistencyError
+	// Flattened values of the attribute, e.g. of members.0.user_id
+	expected string
+	actual   string
+}
+
+// findMismatches returns the attributes of the current state that do not match the original state, in order of their key
+func (c *ConsistencyCheck) findMismatches(currentState *schema.ResourceData) []consistencyMismatch {
 	originalState := filterMap(c.originalState)
 
 	resourceConfig := &terraform.ResourceConfig{
@@ -238,67 +287,54 @@ func (c *ConsistencyCheck) CheckState(currentState *schema.ResourceData) *retry.
 		Raw:          originalState,
 	}
 
+	mismatches := make([]consistencyMismatch, 0)
 	diff, _ := c.r.SimpleDiff(c.ctx, currentState.State(), resourceConfig, c.meta)
-	if diff != nil && len(diff.Attributes) > 0 {
-		for k, v := range diff.Attributes {
-			if strings.HasSuffix(k, "#") {
-				continue
-			}
-			vTemp := v.Old
-			v.Old = v.New
-			v.New = vTemp
-			parts := strings.Split(k, ".")
-			if strings.Contains(k, ".") {
-				slice1Index, _ := strconv.Atoi(parts[1])
-				slice2Index := 0
-				key := ""
-				if len(parts) >= 3 {
-					key = parts[2]
-					if len(parts) == 4 {
-						slice2Index, _ = strconv.Atoi(parts[3])
-					}
-				}
+	if diff == nil || len(diff.Attributes) == 0 {
+		return mismatches
+	}
 
-				vv := v.New
-				if currentState.HasChange(k) {
-					if !compareValues(c.originalState[parts[0]], vv, slice1Index, slice2Index, key) {
-						err := retry.RetryableError(&consistencyError{
-							key:      k,
-							oldValue: c.originalState[k],
-							newValue: currentState.Get(k),
-						})
-
-						if exists := featureToggles.CCToggleExists(); c.checks >= c.maxStateChecks && exists {
-							c.writeConsistencyErrorToFile(currentState, err)
-							return nil
-						}
-
-						c.checks++
-						return err
-					}
-				}
-			} else {
-				if currentState.HasChange(k) {
-					err := retry.RetryableError(&consistencyError{
-						key:      k,
-						oldValue: c.originalState[k],
-						newValue: currentState.Get(k),
-					})
-
-					if exists := featureToggles.CCToggleExists(); c.checks >= c.maxStateChecks && exists {
-						c.writeConsistencyErrorToFile(currentState, err)
-						return nil
-					}
-
-					c.checks++
-					return err
+	keys := make([]string, 0, len(diff.Attributes))
+	for k := range diff.Attributes {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if strings.HasSuffix(k, "#") {
+			continue
+		}
+		// The diff is from the current state to the original state
+		v := diff.Attributes[k]
+		expected, actual := v.New, v.Old
+		if !currentState.HasChange(k) {
+			continue
+		}
+		if strings.Contains(k, ".") {
+			parts := strings.Split(k, ".")
+			slice1Index, _ := strconv.Atoi(parts[1])
+			slice2Index := 0
+			key := ""
+			if len(parts) >= 3 {
+				key = parts[2]
+				if len(parts) == 4 {
+					slice2Index, _ = strconv.Atoi(parts[3])
 				}
 			}
+			if compareValues(c.originalState[parts[0]], actual, slice1Index, slice2Index, key) {
+				continue
+			}
 		}
+		mismatches = append(mismatches, consistencyMismatch{
+			err: &consistencyError{
+				key:      k,
+				oldValue: c.originalState[k],
+				newValue: currentState.Get(k),
+			},
+			expected: expected,
+			actual:   actual,
+		})
 	}
-
-	DeleteConsistencyCheck(currentState.Id())
-	return nil
+	return mismatches
 }
 
 func (c *ConsistencyCheck) writeConsistencyErrorToFile(d *schema.ResourceData, consistencyError *retry.RetryError) {
@@ -0,0 +1,90 @@
+package consistency_checker
+
+import (
+	"encoding/json"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+When the CONSISTENCY_CHECKER_REPORT_FILE environment variable is set, every mismatch found by the consistency checker is appended to
+the file it names as a line of JSON. Unlike consistency-errors.log.json, which only keeps the last error, the report accumulates the
+mismatches of every resource across the run. A mismatch is only written again when its actual value or outcome changes on a later
+check, so the report shows when the checker gave up on a mismatch it retried before.
+Terraform does not send the address of a resource to the provider, so resources are identified by their type and ID.
+*/
+
+const (
+	outcomeRetry    = "retry"
+	outcomeWarning  = "warning"
+	outcomeBypassed = "bypassed"
+)
+
+var reportMutex sync.Mutex
+
+type consistencyReportEntry struct {
+	Time         time.Time `json:"time"`
+	ResourceType string    `json:"resource_type"`
+	ResourceId   string    `json:"resource_id"`
+	Name         string    `json:"name,omitempty"`
+	Attribute    string    `json:"attribute"`
+	Expected     string    `json:"expected"`
+	Actual       string    `json:"actual"`
+	// Number of the check of the resource, starting at 1
+	Check int `json:"check"`
+	// What the consistency checker did about the mismatch: retry the read, log a warning, or bypass the check
+	Outcome string `json:"outcome"`
+}
+
+// reportMismatches appends the mismatches that were not reported yet to a JSON Lines file
+func (c *ConsistencyCheck) reportMismatches(filePath string, d *schema.ResourceData, mismatches []consistencyMismatch, outcome string) {
+	if c.reported == nil {
+		c.reported = make(map[string]bool)
+	}
+	name, _ := d.Get("name").(string)
+
+	var data []byte
+	for _, mismatch := range mismatches {
+		reportKey := mismatch.err.key + "\x00" + mismatch.actual + "\x00" + outcome
+		if c.reported[reportKey] {
+			continue
+		}
+		c.reported[reportKey] = true
+
+		line, err := json.Marshal(consistencyReportEntry{
+			Time:         time.Now(),
+			ResourceType: c.resourceType,
+			ResourceId:   d.Id(),
+			Name:         name,
+			Attribute:    mismatch.err.key,
+			Expected:     mismatch.expected,
+			Actual:       mismatch.actual,
+			Check:        c.checks + 1,
+			Outcome:      outcome,
+		})
+		if err != nil {
+			log.Printf("Error marshaling consistency error of %s %s: %v", c.resourceType, d.Id(), err)
+			continue
+		}
+		data = append(append(data, line...), '\n')
+	}
+	if len(data) == 0 {
+		return
+	}
+
+	reportMutex.Lock()
+	defer reportMutex.Unlock()
+	file, err := os.OpenFile(filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		log.Printf("Error opening file %s: %v", filePath, err)
+		return
+	}
+	defer file.Close()
+	if _, err := file.Write(data); err != nil {
+		log.Printf("Error writing file %s: %v", filePath, err)
+	}
+}
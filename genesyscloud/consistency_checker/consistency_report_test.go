@@ -0,0 +1,107 @@
+package consistency_checker
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestUnitConsistencyReport verifies every mismatch is appended to the report file and mismatches are not retried in warn only mode
+func TestUnitConsistencyReport(t *testing.T) {
+	reportFile := filepath.Join(t.TempDir(), "consistency-report.jsonl")
+	t.Setenv("CONSISTENCY_CHECKER_REPORT_FILE", reportFile)
+
+	resourceSchema := map[string]*schema.Schema{
+		"name":        {Type: schema.TypeString, Optional: true},
+		"description": {Type: schema.TypeString, Optional: true},
+		"division_id": {Type: schema.TypeString, Optional: true},
+	}
+	resource := &schema.Resource{Schema: resourceSchema}
+	resourceData := func(description string, divisionId string) *schema.ResourceData {
+		d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{
+			"name":        "Sales",
+			"description": description,
+			"division_id": divisionId,
+		})
+		d.SetId("queue-1")
+		return d
+	}
+
+	cc := NewConsistencyCheck(context.Background(), resourceData("Sales queue", "division-1"), nil, resource, 5, "genesyscloud_test")
+	defer DeleteConsistencyCheck("queue-1")
+
+	current := resourceData("Old description", "division-2")
+	assert.NotNil(t, cc.CheckState(current))
+	// The same mismatches are only reported once
+	assert.NotNil(t, cc.CheckState(current))
+
+	t.Setenv("CONSISTENCY_CHECKER_WARN_ONLY", "true")
+	assert.Nil(t, cc.CheckState(resourceData("Older description", "division-2")))
+
+	entries := readConsistencyReport(t, reportFile)
+	if assert.Len(t, entries, 4) {
+		assert.Equal(t, "description", entries[0].Attribute)
+		assert.Equal(t, "Sales queue", entries[0].Expected)
+		assert.Equal(t, "Old description", entries[0].Actual)
+		assert.Equal(t, "genesyscloud_test", entries[0].ResourceType)
+		assert.Equal(t, "queue-1", entries[0].ResourceId)
+		assert.Equal(t, "Sales", entries[0].Name)
+		assert.Equal(t, outcomeRetry, entries[0].Outcome)
+		assert.Equal(t, 1, entries[0].Check)
+
+		assert.Equal(t, "division_id", entries[1].Attribute)
+		assert.Equal(t, "division-2", entries[1].Actual)
+
+		assert.Equal(t, "Older description", entries[2].Actual)
+		assert.Equal(t, outcomeWarning, entries[2].Outcome)
+		assert.Equal(t, 3, entries[2].Check)
+
+		// Mismatches are reported again when the checker does something else about them
+		assert.Equal(t, "division-2", entries[3].Actual)
+		assert.Equal(t, outcomeWarning, entries[3].Outcome)
+	}
+}
+
+// TestUnitConsistencyReportBypassed verifies a mismatch that was retried before is reported again when the last check bypasses it
+func TestUnitConsistencyReportBypassed(t *testing.T) {
+	reportFile := filepath.Join(t.TempDir(), "consistency-report.jsonl")
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{"name": {Type: schema.TypeString, Optional: true}}, map[string]interface{}{"name": "Sales"})
+	d.SetId("queue-1")
+	mismatches := []consistencyMismatch{{err: &consistencyError{key: "name"}, expected: "Sales", actual: "Support"}}
+
+	cc := &ConsistencyCheck{resourceType: "genesyscloud_test"}
+	cc.reportMismatches(reportFile, d, mismatches, outcomeRetry)
+	cc.reportMismatches(reportFile, d, mismatches, outcomeRetry)
+	cc.reportMismatches(reportFile, d, mismatches, outcomeBypassed)
+
+	entries := readConsistencyReport(t, reportFile)
+	if assert.Len(t, entries, 2) {
+		assert.Equal(t, outcomeRetry, entries[0].Outcome)
+		assert.Equal(t, outcomeBypassed, entries[1].Outcome)
+		assert.Equal(t, "Support", entries[1].Actual)
+	}
+}
+
+func readConsistencyReport(t *testing.T, reportFile string) []consistencyReportEntry {
+	file, err := os.Open(reportFile)
+	if err != nil {
+		t.Fatalf("failed to open report file: %v", err)
+	}
+	defer file.Close()
+	var entries []consistencyReportEntry
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var entry consistencyReportEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			t.Fatalf("failed to parse report line %s: %v", scanner.Text(), err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
 )
@@ -52,14 +53,13 @@ func newConversationsMessagingIntegrationsInstagramProxy(clientConfig *platformc
 	}
 }
 
-// getConversationsMessagingIntegrationsInstagramProxy acts as a singleton to for the internalProxy.  It also ensures
+// getConversationsMessagingIntegrationsInstagramProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getConversationsMessagingIntegrationsInstagramProxy(clientConfig *platformclientv2.Configuration) *conversationsMessagingIntegrationsInstagramProxy {
-	if internalProxy == nil {
-		internalProxy = newConversationsMessagingIntegrationsInstagramProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return provider.GetProxy(clientConfig, newConversationsMessagingIntegrationsInstagramProxy)
 }
 
 // createConversationsMessagingIntegrationsInstagram creates a Genesys Cloud conversations messaging integrations instagram
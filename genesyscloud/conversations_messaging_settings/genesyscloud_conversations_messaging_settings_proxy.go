@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
@@ -51,13 +52,13 @@ func newConversationsMessagingSettingsProxy(clientConfig *platformclientv2.Confi
 	}
 }
 
-// getConversationsMessagingSettingsProxy acts as a singleton to for the internalProxy.  It also ensures
+// getConversationsMessagingSettingsProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getConversationsMessagingSettingsProxy(clientConfig *platformclientv2.Configuration) *conversationsMessagingSettingsProxy {
-	if internalProxy == nil {
-		internalProxy = newConversationsMessagingSettingsProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newConversationsMessagingSettingsProxy)
 }
 
 // getConversationsMessagingSettings retrieves all Genesys Cloud conversations messaging settings
@@ -2,6 +2,7 @@ package conversations_messaging_settings_default
 
 import (
 	"context"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
 )
@@ -41,13 +42,13 @@ func newConversationsMessagingSettingsDefaultProxy(clientConfig *platformclientv
 	}
 }
 
-// getConversationsMessagingSettingsDefaultProxy acts as a singleton to for the internalProxy.  It also ensures
+// getConversationsMessagingSettingsDefaultProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getConversationsMessagingSettingsDefaultProxy(clientConfig *platformclientv2.Configuration) *conversationsMessagingSettingsDefaultProxy {
-	if internalProxy == nil {
-		internalProxy = newConversationsMessagingSettingsDefaultProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newConversationsMessagingSettingsDefaultProxy)
 }
 
 // getConversationsMessagingSettingsDefault returns a single Genesys Cloud conversations messaging settings default by Id
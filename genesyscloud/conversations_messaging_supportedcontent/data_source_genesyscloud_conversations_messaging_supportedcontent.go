@@ -29,11 +29,8 @@ func dataSourceSupportedContentRead(ctx context.Context, d *schema.ResourceData,
 
 	key = d.Get("name").(string)
 
-	if dataSourceSupportedContentCache == nil {
-		dataSourceSupportedContentCache = rc.NewDataSourceCache(sdkConfig, hydrateSupportedContentCacheFn, getSupportedContentIdByName)
-	}
-
-	contentId, err := rc.RetrieveId(dataSourceSupportedContentCache, resourceName, key, ctx)
+	cache := rc.GetDataSourceCache(sdkConfig, resourceName, newSupportedContentDataSourceCache)
+	contentId, err := rc.RetrieveId(cache, resourceName, key, meta.(*provider.ProviderMeta).DataSourceCache, ctx)
 	if err != nil {
 		return err
 	}
@@ -42,10 +39,6 @@ func dataSourceSupportedContentRead(ctx context.Context, d *schema.ResourceData,
 	return nil
 }
 
-var (
-	dataSourceSupportedContentCache *rc.DataSourceCache
-)
-
 func hydrateSupportedContentCacheFn(c *rc.DataSourceCache) error {
 	log.Printf("hydrating cache for data source " + resourceName)
 	supportContentApi := platformclientv2.NewConversationsApiWithConfig(c.ClientConfig)
@@ -106,3 +99,10 @@ func getSupportedContentIdByName(c *rc.DataSourceCache, searchName string, ctx c
 
 	return contentId, diag
 }
+
+func newSupportedContentDataSourceCache(sdkConfig *platformclientv2.Configuration) *rc.DataSourceCache {
+	cache := rc.NewDataSourceCache(sdkConfig, hydrateSupportedContentCacheFn, getSupportedContentIdByName)
+	cache.RevalidatePath = "/api/v2/conversations/messaging/supportedcontent/{id}"
+	cache.RevalidateFields = []string{"name"}
+	return cache
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
@@ -56,14 +57,13 @@ func newSupportedContentProxy(clientConfig *platformclientv2.Configuration) *sup
 	}
 }
 
-// getSupportedContentProxy acts as a singleton to for the internalProxy.  It also ensures
+// getSupportedContentProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getSupportedContentProxy(clientConfig *platformclientv2.Configuration) *supportedContentProxy {
-	if internalProxy == nil {
-		internalProxy = newSupportedContentProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return provider.GetProxy(clientConfig, newSupportedContentProxy)
 }
 
 // createSupportedContent creates a Genesys Cloud supported content
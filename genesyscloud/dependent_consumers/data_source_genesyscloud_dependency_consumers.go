@@ -0,0 +1,96 @@
+package dependent_consumers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
+)
+
+func dataSourceDependencyConsumersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := GetDependentConsumerProxy(sdkConfig)
+
+	objectId := d.Get("object_id").(string)
+	resourceType := d.Get("resource_type").(string)
+	objectType := d.Get("object_type").(string)
+
+	// The dependency tracking type of a flow depends on its type, and its dependencies on its published version
+	var flow *platformclientv2.Flow
+	if resourceType == gflow || (resourceType == "" && SetDependentObjectMaps()[objectType] == gflow) {
+		var resp *platformclientv2.APIResponse
+		var err error
+		flow, resp, err = proxy.GetFlow(ctx, objectId)
+		if err != nil {
+			return util.BuildAPIDiagnosticError(dataSourceName, fmt.Sprintf("Failed to get flow %s: %s", objectId, err), resp)
+		}
+		if objectType == "" && flow.VarType != nil {
+			objectType = SetFlowTypeObjectMaps()[*flow.VarType]
+		}
+		if objectType == "" {
+			return util.BuildDiagnosticError(dataSourceName, fmt.Sprintf("Dependency tracking is not supported for flow %s", objectId), fmt.Errorf("unsupported flow type %v", flow.VarType))
+		}
+	} else if objectType == "" {
+		objectType = objectTypeForResourceType(resourceType)
+	}
+
+	consumers, resp, err := proxy.GetConsumingResources(ctx, objectId, objectType, d.Get("flow_filter").(string))
+	if err != nil {
+		return util.BuildAPIDiagnosticError(dataSourceName, fmt.Sprintf("Failed to get the consumers of %s %s: %s", objectType, objectId, err), resp)
+	}
+
+	var dependencies *[]platformclientv2.Dependency
+	if flow != nil && flow.PublishedVersion != nil && flow.PublishedVersion.Id != nil {
+		dependencies, resp, err = proxy.GetConsumedResources(ctx, objectId, *flow.PublishedVersion.Id, objectType)
+		if err != nil {
+			return util.BuildAPIDiagnosticError(dataSourceName, fmt.Sprintf("Failed to get the dependencies of flow %s: %s", objectId, err), resp)
+		}
+	}
+
+	d.SetId(objectId)
+	_ = d.Set("object_type", objectType)
+	_ = d.Set("consumers", flattenDependencies(consumers))
+	_ = d.Set("dependencies", flattenDependencies(dependencies))
+	return nil
+}
+
+// objectTypeForResourceType returns the dependency tracking type of the objects of a resource type. Resource types with several
+// dependency tracking types, e.g. languages, return the first of them in alphabetical order.
+func objectTypeForResourceType(resourceType string) string {
+	objectTypes := make([]string, 0)
+	for objectType, mappedType := range SetDependentObjectMaps() {
+		if mappedType == resourceType {
+			objectTypes = append(objectTypes, objectType)
+		}
+	}
+	if len(objectTypes) == 0 {
+		return ""
+	}
+	sort.Strings(objectTypes)
+	return objectTypes[0]
+}
+
+func flattenDependencies(dependencies *[]platformclientv2.Dependency) []interface{} {
+	if dependencies == nil {
+		return []interface{}{}
+	}
+	dependencyList := make([]interface{}, 0, len(*dependencies))
+	for _, dependency := range *dependencies {
+		dependencyMap := make(map[string]interface{})
+		resourcedata.SetMapValueIfNotNil(dependencyMap, "id", dependency.Id)
+		resourcedata.SetMapValueIfNotNil(dependencyMap, "name", dependency.Name)
+		resourcedata.SetMapValueIfNotNil(dependencyMap, "type", dependency.VarType)
+		resourcedata.SetMapValueIfNotNil(dependencyMap, "version", dependency.Version)
+		if dependency.VarType != nil {
+			dependencyMap["resource_type"] = SetDependentObjectMaps()[*dependency.VarType]
+		}
+		dependencyList = append(dependencyList, dependencyMap)
+	}
+	return dependencyList
+}
@@ -0,0 +1,106 @@
+package dependent_consumers
+
+import (
+	"sort"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const dataSourceName = "genesyscloud_dependency_consumers"
+
+func SetRegistrar(regInstance registrar.Registrar) {
+	regInstance.RegisterDataSource(dataSourceName, DataSourceDependencyConsumers())
+}
+
+var dependencyResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"id": {
+			Description: "ID of the object.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"name": {
+			Description: "Name of the object.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"type": {
+			Description: "Dependency tracking type of the object, e.g. `INBOUNDCALLFLOW`.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"resource_type": {
+			Description: "Resource type of the object, e.g. `genesyscloud_flow`. Empty for objects that are not managed by a resource of this provider.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"version": {
+			Description: "Version of the object, e.g. the version of a flow.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	},
+}
+
+func DataSourceDependencyConsumers() *schema.Resource {
+	return &schema.Resource{
+		Description:        "Data source for the objects that consume a Genesys Cloud object, as reported by Architect dependency tracking. Select the object by its ID and resource type or dependency tracking type. The objects consumed by the published version of a flow are also returned.",
+		ReadWithoutTimeout: provider.ReadWithPooledClient(dataSourceDependencyConsumersRead),
+		Schema: map[string]*schema.Schema{
+			"object_id": {
+				Description: "ID of the object.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"resource_type": {
+				Description:  "Resource type of the object, e.g. `genesyscloud_routing_skill`. The dependency tracking type of flows is looked up from the flow.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(dependencyTrackingResourceTypes(), false),
+				ExactlyOneOf: []string{"resource_type", "object_type"},
+			},
+			"object_type": {
+				Description:  "Dependency tracking type of the object, e.g. `ACDSKILL`. Computed from `resource_type` when it is not set.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"resource_type", "object_type"},
+			},
+			"flow_filter": {
+				Description:  "Only return consuming flows that are `published` or `checkedIn`. Defaults to all consuming flows.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"published", "checkedIn"}, false),
+			},
+			"consumers": {
+				Description: "Objects that consume the object.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        dependencyResource,
+			},
+			"dependencies": {
+				Description: "Objects consumed by the published version of the object. Only set when the object is a published flow.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        dependencyResource,
+			},
+		},
+	}
+}
+
+// dependencyTrackingResourceTypes returns the resource types of the objects tracked by Architect dependency tracking
+func dependencyTrackingResourceTypes() []string {
+	seen := make(map[string]bool)
+	resourceTypes := make([]string, 0)
+	for _, resourceType := range SetDependentObjectMaps() {
+		if !seen[resourceType] {
+			seen[resourceType] = true
+			resourceTypes = append(resourceTypes, resourceType)
+		}
+	}
+	sort.Strings(resourceTypes)
+	return resourceTypes
+}
@@ -0,0 +1,81 @@
+package dependent_consumers
+
+import (
+	"context"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestUnitDataSourceDependencyConsumers verifies the consumers of an object and the dependencies of a published flow are returned
+func TestUnitDataSourceDependencyConsumers(t *testing.T) {
+	flowId := "flow-1"
+	skillId := "skill-1"
+	proxy := &DependentConsumerProxy{}
+	proxy.GetFlowAttr = func(_ context.Context, _ *DependentConsumerProxy, id string) (*platformclientv2.Flow, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, flowId, id)
+		return &platformclientv2.Flow{
+			Id:               &id,
+			VarType:          platformclientv2.String("INBOUNDCALL"),
+			PublishedVersion: &platformclientv2.Flowversion{Id: platformclientv2.String("3.0")},
+		}, nil, nil
+	}
+	proxy.GetConsumingResourcesAttr = func(_ context.Context, _ *DependentConsumerProxy, id string, objectType string, flowFilter string) (*[]platformclientv2.Dependency, *platformclientv2.APIResponse, error) {
+		if id == skillId {
+			assert.Equal(t, "ACDSKILL", objectType)
+			assert.Equal(t, "published", flowFilter)
+			return &[]platformclientv2.Dependency{{
+				Id:      &flowId,
+				Name:    platformclientv2.String("Main Menu"),
+				VarType: platformclientv2.String("INBOUNDCALLFLOW"),
+				Version: platformclientv2.String("3.0"),
+			}}, nil, nil
+		}
+		assert.Equal(t, "INBOUNDCALLFLOW", objectType)
+		return &[]platformclientv2.Dependency{}, nil, nil
+	}
+	proxy.GetConsumedResourcesAttr = func(_ context.Context, _ *DependentConsumerProxy, id string, version string, objectType string) (*[]platformclientv2.Dependency, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, "3.0", version)
+		return &[]platformclientv2.Dependency{
+			{Id: &skillId, Name: platformclientv2.String("Sales"), VarType: platformclientv2.String("ACDSKILL")},
+			{Id: platformclientv2.String("prompt-1"), Name: platformclientv2.String("Welcome"), VarType: platformclientv2.String("SYSTEMPROMPT")},
+		}, nil, nil
+	}
+	InternalProxy = proxy
+	defer func() { InternalProxy = nil }()
+
+	ctx := context.Background()
+	gcloud := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+	resourceSchema := DataSourceDependencyConsumers().Schema
+
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{
+		"object_id":     skillId,
+		"resource_type": "genesyscloud_routing_skill",
+		"flow_filter":   "published",
+	})
+	assert.False(t, dataSourceDependencyConsumersRead(ctx, d, gcloud).HasError())
+	assert.Equal(t, skillId, d.Id())
+	assert.Equal(t, "ACDSKILL", d.Get("object_type"))
+	assert.Equal(t, []interface{}{map[string]interface{}{
+		"id":            flowId,
+		"name":          "Main Menu",
+		"type":          "INBOUNDCALLFLOW",
+		"resource_type": "genesyscloud_flow",
+		"version":       "3.0",
+	}}, d.Get("consumers"))
+	assert.Empty(t, d.Get("dependencies"))
+
+	d = schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{
+		"object_id":     flowId,
+		"resource_type": "genesyscloud_flow",
+	})
+	assert.False(t, dataSourceDependencyConsumersRead(ctx, d, gcloud).HasError())
+	assert.Equal(t, "INBOUNDCALLFLOW", d.Get("object_type"))
+	assert.Empty(t, d.Get("consumers"))
+	assert.Equal(t, 2, d.Get("dependencies.#"))
+	assert.Equal(t, "genesyscloud_routing_skill", d.Get("dependencies.0.resource_type"))
+	assert.Equal(t, "", d.Get("dependencies.1.resource_type"))
+}
@@ -19,6 +19,9 @@ type DependentConsumerProxy struct {
 	ArchitectApi                   *platformclientv2.ArchitectApi
 	RetrieveDependentConsumersAttr retrieveDependentConsumersFunc
 	GetPooledClientAttr            retrievePooledClientFunc
+	GetFlowAttr                    getFlowFunc
+	GetConsumingResourcesAttr      getConsumingResourcesFunc
+	GetConsumedResourcesAttr       getConsumedResourcesFunc
 }
 
 var gflow = "genesyscloud_flow"
@@ -27,40 +30,68 @@ func (p *DependentConsumerProxy) GetDependentConsumers(ctx context.Context, reso
 	return p.RetrieveDependentConsumersAttr(ctx, p, resourceKeys)
 }
 
-func (p *DependentConsumerProxy) GetAllWithPooledClient(method provider.GetCustomConfigFunc) (resourceExporter.ResourceIDMetaMap, *resourceExporter.DependencyResource, diag.Diagnostics) {
-	return p.GetPooledClientAttr(method)
+// GetAllWithPooledClient runs the method with a client from the pool carried by the context, see provider.WithClientPool
+func (p *DependentConsumerProxy) GetAllWithPooledClient(ctx context.Context, method provider.GetCustomConfigFunc) (resourceExporter.ResourceIDMetaMap, *resourceExporter.DependencyResource, diag.Diagnostics) {
+	return p.GetPooledClientAttr(ctx, method)
+}
+
+// GetFlow returns the flow with the given ID
+func (p *DependentConsumerProxy) GetFlow(ctx context.Context, id string) (*platformclientv2.Flow, *platformclientv2.APIResponse, error) {
+	return p.GetFlowAttr(ctx, p, id)
+}
+
+// GetConsumingResources returns the objects that consume the dependency tracking object with the given ID and type. The flow filter
+// limits the consuming flows to "published" or "checkedIn" flows when set.
+func (p *DependentConsumerProxy) GetConsumingResources(ctx context.Context, id string, objectType string, flowFilter string) (*[]platformclientv2.Dependency, *platformclientv2.APIResponse, error) {
+	return p.GetConsumingResourcesAttr(ctx, p, id, objectType, flowFilter)
+}
+
+// GetConsumedResources returns the objects consumed by a version of the dependency tracking object with the given ID and type
+func (p *DependentConsumerProxy) GetConsumedResources(ctx context.Context, id string, version string, objectType string) (*[]platformclientv2.Dependency, *platformclientv2.APIResponse, error) {
+	return p.GetConsumedResourcesAttr(ctx, p, id, version, objectType)
 }
 
 type retrieveDependentConsumersFunc func(ctx context.Context, p *DependentConsumerProxy, resourceKeys resourceExporter.ResourceInfo) (resourceExporter.ResourceIDMetaMap, *resourceExporter.DependencyResource, error)
-type retrievePooledClientFunc func(method provider.GetCustomConfigFunc) (resourceExporter.ResourceIDMetaMap, *resourceExporter.DependencyResource, diag.Diagnostics)
+type retrievePooledClientFunc func(ctx context.Context, method provider.GetCustomConfigFunc) (resourceExporter.ResourceIDMetaMap, *resourceExporter.DependencyResource, diag.Diagnostics)
+type getFlowFunc func(ctx context.Context, p *DependentConsumerProxy, id string) (*platformclientv2.Flow, *platformclientv2.APIResponse, error)
+type getConsumingResourcesFunc func(ctx context.Context, p *DependentConsumerProxy, id string, objectType string, flowFilter string) (*[]platformclientv2.Dependency, *platformclientv2.APIResponse, error)
+type getConsumedResourcesFunc func(ctx context.Context, p *DependentConsumerProxy, id string, version string, objectType string) (*[]platformclientv2.Dependency, *platformclientv2.APIResponse, error)
 
 var InternalProxy *DependentConsumerProxy
 
-// GetDependentConsumerProxy acts as a singleton to for the InternalProxy.
+// GetDependentConsumerProxy returns the proxy of the provider instance that owns the client config. It also ensures
+// that we can still proxy our tests by directly setting InternalProxy package variable
 func GetDependentConsumerProxy(ClientConfig *platformclientv2.Configuration) *DependentConsumerProxy {
-	return newDependentConsumerProxy(ClientConfig)
+	if InternalProxy != nil {
+		return InternalProxy
+	}
+	if ClientConfig == nil {
+		return newDependentConsumerProxy(nil)
+	}
+	return provider.GetProxy(ClientConfig, newDependentConsumerProxy)
 }
 
 // newDependentConsumerProxy initializes the ruleset proxy with all of the data needed to communicate with Genesys Cloud
 func newDependentConsumerProxy(ClientConfig *platformclientv2.Configuration) *DependentConsumerProxy {
-	if InternalProxy == nil {
-		InternalProxy = &DependentConsumerProxy{
-			GetPooledClientAttr: retrievePooledClientFn,
-		}
+	proxy := &DependentConsumerProxy{
+		GetPooledClientAttr: retrievePooledClientFn,
 	}
 
 	if ClientConfig != nil {
 		api := platformclientv2.NewArchitectApiWithConfig(ClientConfig)
-		InternalProxy.ClientConfig = ClientConfig
-		InternalProxy.ArchitectApi = api
-		InternalProxy.RetrieveDependentConsumersAttr = retrieveDependentConsumersFn
+		proxy.ClientConfig = ClientConfig
+		proxy.ArchitectApi = api
+		proxy.RetrieveDependentConsumersAttr = retrieveDependentConsumersFn
+		proxy.GetFlowAttr = getFlowFn
+		proxy.GetConsumingResourcesAttr = getConsumingResourcesFn
+		proxy.GetConsumedResourcesAttr = getConsumedResourcesFn
 	}
-	return InternalProxy
+	return proxy
 }
 
-func retrievePooledClientFn(method provider.GetCustomConfigFunc) (resourceExporter.ResourceIDMetaMap, *resourceExporter.DependencyResource, diag.Diagnostics) {
+func retrievePooledClientFn(ctx context.Context, method provider.GetCustomConfigFunc) (resourceExporter.ResourceIDMetaMap, *resourceExporter.DependencyResource, diag.Diagnostics) {
 	resourceFunc := provider.GetAllWithPooledClientCustom(method)
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 	resources, dependsMap, err := resourceFunc(ctx)
 	if err != nil {
@@ -69,6 +100,46 @@ func retrievePooledClientFn(method provider.GetCustomConfigFunc) (resourceExport
 	return resources, dependsMap, err
 }
 
+func getFlowFn(_ context.Context, p *DependentConsumerProxy, id string) (*platformclientv2.Flow, *platformclientv2.APIResponse, error) {
+	return p.ArchitectApi.GetFlow(id, false)
+}
+
+func getConsumingResourcesFn(_ context.Context, p *DependentConsumerProxy, id string, objectType string, flowFilter string) (*[]platformclientv2.Dependency, *platformclientv2.APIResponse, error) {
+	const pageSize = 100
+	consumers := make([]platformclientv2.Dependency, 0)
+	for pageNum := 1; ; pageNum++ {
+		page, resp, err := p.ArchitectApi.GetArchitectDependencytrackingConsumingresources(id, objectType, nil, "", pageNum, pageSize, flowFilter)
+		if err != nil {
+			return nil, resp, err
+		}
+		if page.Entities == nil || len(*page.Entities) == 0 {
+			return &consumers, resp, nil
+		}
+		consumers = append(consumers, *page.Entities...)
+		if page.PageCount == nil || pageNum >= *page.PageCount {
+			return &consumers, resp, nil
+		}
+	}
+}
+
+func getConsumedResourcesFn(_ context.Context, p *DependentConsumerProxy, id string, version string, objectType string) (*[]platformclientv2.Dependency, *platformclientv2.APIResponse, error) {
+	const pageSize = 100
+	dependencies := make([]platformclientv2.Dependency, 0)
+	for pageNum := 1; ; pageNum++ {
+		page, resp, err := p.ArchitectApi.GetArchitectDependencytrackingConsumedresources(id, version, objectType, nil, pageNum, pageSize)
+		if err != nil {
+			return nil, resp, err
+		}
+		if page.Entities == nil || len(*page.Entities) == 0 {
+			return &dependencies, resp, nil
+		}
+		dependencies = append(dependencies, *page.Entities...)
+		if page.PageCount == nil || pageNum >= *page.PageCount {
+			return &dependencies, resp, nil
+		}
+	}
+}
+
 func retrieveDependentConsumersFn(ctx context.Context, p *DependentConsumerProxy, resourceKeys resourceExporter.ResourceInfo) (resourceExporter.ResourceIDMetaMap, *resourceExporter.DependencyResource, error) {
 	resourceKey := resourceKeys.State.ID
 	resourceName := resourceKeys.Name
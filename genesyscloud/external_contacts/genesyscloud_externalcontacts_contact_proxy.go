@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 
@@ -72,13 +73,13 @@ func newExternalContactsContactsProxy(clientConfig *platformclientv2.Configurati
 	}
 }
 
-// getExternalContactsContactsProxy acts as a singleton to for the internalProxy.  It also ensures
+// getExternalContactsContactsProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getExternalContactsContactsProxy(clientConfig *platformclientv2.Configuration) *externalContactsContactsProxy {
-	if internalProxy == nil {
-		internalProxy = newExternalContactsContactsProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newExternalContactsContactsProxy)
 }
 
 // getAllExternalContacts retrieves all Genesys Cloud External Contacts
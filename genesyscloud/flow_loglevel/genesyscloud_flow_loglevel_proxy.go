@@ -3,6 +3,7 @@ package flow_loglevel
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
 )
@@ -63,14 +64,13 @@ func newFlowLogLevelProxy(clientConfig *platformclientv2.Configuration) *flowLog
 	}
 }
 
-// getFlowLogLevelProxy acts as a singleton to for the internalProxy.  It also ensures
+// getFlowLogLevelProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getFlowLogLevelProxy(clientConfig *platformclientv2.Configuration) *flowLogLevelProxy {
-	if internalProxy == nil {
-		internalProxy = newFlowLogLevelProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return provider.GetProxy(clientConfig, newFlowLogLevelProxy)
 }
 
 // getAllFlowLogLevels retrieves all Genesys Cloud Flow Log Levels
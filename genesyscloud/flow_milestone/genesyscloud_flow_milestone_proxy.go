@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
 )
@@ -52,13 +53,13 @@ func newFlowMilestoneProxy(clientConfig *platformclientv2.Configuration) *flowMi
 	}
 }
 
-// getFlowMilestoneProxy acts as a singleton to for the internalProxy.  It also ensures
+// getFlowMilestoneProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getFlowMilestoneProxy(clientConfig *platformclientv2.Configuration) *flowMilestoneProxy {
-	if internalProxy == nil {
-		internalProxy = newFlowMilestoneProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newFlowMilestoneProxy)
 }
 
 // createFlowMilestone creates a Genesys Cloud flow milestone
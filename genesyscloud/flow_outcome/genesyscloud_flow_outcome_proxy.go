@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
 )
@@ -49,13 +50,13 @@ func newFlowOutcomeProxy(clientConfig *platformclientv2.Configuration) *flowOutc
 	}
 }
 
-// getFlowOutcomeProxy acts as a singleton to for the internalProxy.  It also ensures
+// getFlowOutcomeProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getFlowOutcomeProxy(clientConfig *platformclientv2.Configuration) *flowOutcomeProxy {
-	if internalProxy == nil {
-		internalProxy = newFlowOutcomeProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newFlowOutcomeProxy)
 }
 
 // createFlowOutcome creates a Genesys Cloud flow outcome
@@ -3,6 +3,7 @@ package group
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
@@ -55,11 +56,10 @@ func newGroupProxy(clientConfig *platformclientv2.Configuration) *groupProxy {
 }
 
 func getGroupProxy(clientConfig *platformclientv2.Configuration) *groupProxy {
-	if internalProxy == nil {
-		internalProxy = newGroupProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return provider.GetProxy(clientConfig, newGroupProxy)
 }
 
 func (p *groupProxy) createGroup(ctx context.Context, group *platformclientv2.Groupcreate) (*platformclientv2.Group, *platformclientv2.APIResponse, error) {
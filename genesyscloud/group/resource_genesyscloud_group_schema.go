@@ -46,7 +46,8 @@ func SetRegistrar(regInstance registrar.Registrar) {
 
 func GroupExporter() *resourceExporter.ResourceExporter {
 	return &resourceExporter.ResourceExporter{
-		GetResourcesFunc: provider.GetAllWithPooledClient(getAllGroups),
+		GetResourcesFunc:    provider.GetAllWithPooledClient(getAllGroups),
+		LookupReferenceFunc: provider.LookupReferenceWithPooledClient(lookupGroupReference),
 		RefAttrs: map[string]*resourceExporter.RefAttrSettings{
 			"owner_ids":  {RefType: "genesyscloud_user"},
 			"member_ids": {RefType: "genesyscloud_user"},
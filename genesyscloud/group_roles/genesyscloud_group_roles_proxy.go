@@ -3,6 +3,7 @@ package group_roles
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	"terraform-provider-genesyscloud/genesyscloud/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -33,10 +34,10 @@ func newGroupRolesProxy(clientConfig *platformclientv2.Configuration) *groupRole
 }
 
 func getGroupRolesProxy(clientConfig *platformclientv2.Configuration) *groupRolesProxy {
-	if internalProxy == nil {
-		internalProxy = newGroupRolesProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newGroupRolesProxy)
 }
 
 func (p *groupRolesProxy) getGroupRolesById(ctx context.Context, roleId string) (*[]platformclientv2.Authzgrant, *platformclientv2.APIResponse, error) {
@@ -66,7 +67,7 @@ func getGroupRolesByIdFn(_ context.Context, p *groupRolesProxy, roleId string) (
 	return &grants, resp, nil
 }
 
-func updateGroupRolesFn(_ context.Context, p *groupRolesProxy, roleId string, rolesConfig *schema.Set, subjectType string) (*platformclientv2.APIResponse, error) {
+func updateGroupRolesFn(ctx context.Context, p *groupRolesProxy, roleId string, rolesConfig *schema.Set, subjectType string) (*platformclientv2.APIResponse, error) {
 	// Get existing roles/divisions
 	subject, resp, err := p.authorizationApi.GetAuthorizationSubject(roleId, true)
 	grants, resp, err := getAssignedGrants(*subject.Id, p)
@@ -102,7 +103,7 @@ func updateGroupRolesFn(_ context.Context, p *groupRolesProxy, roleId string, ro
 	}
 	if len(grantsToAdd) > 0 {
 		// In some cases new roles or divisions have not yet been added to the auth service cache causing 404s that should be retried.
-		diagErr := util.RetryWhen(util.IsStatus404, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		diagErr := util.RetryWhen(ctx, util.IsStatus404, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 			resp, err := p.authorizationApi.PostAuthorizationSubjectBulkadd(roleId, roleDivPairsToGrants(grantsToAdd), subjectType)
 			if err != nil {
 				return resp, util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("failed to add role grants for subject %s: %s", roleId, err), resp)
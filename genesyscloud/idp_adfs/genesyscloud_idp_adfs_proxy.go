@@ -2,6 +2,7 @@ package idp_adfs
 
 import (
 	"context"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
 )
@@ -41,14 +42,13 @@ func newIdpAdfsProxy(clientConfig *platformclientv2.Configuration) *idpAdfsProxy
 	}
 }
 
-// getIdpAdfsProxy acts as a singleton to for the internalProxy.  It also ensures
+// getIdpAdfsProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getIdpAdfsProxy(clientConfig *platformclientv2.Configuration) *idpAdfsProxy {
-	if internalProxy == nil {
-		internalProxy = newIdpAdfsProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return provider.GetProxy(clientConfig, newIdpAdfsProxy)
 }
 
 // getIdpAdfs retrieves all Genesys Cloud idp adfs
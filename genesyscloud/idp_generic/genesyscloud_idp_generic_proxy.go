@@ -2,6 +2,7 @@ package idp_generic
 
 import (
 	"context"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
 )
@@ -41,14 +42,13 @@ func newIdpGenericProxy(clientConfig *platformclientv2.Configuration) *idpGeneri
 	}
 }
 
-// getIdpGenericProxy acts as a singleton to for the internalProxy.  It also ensures
+// getIdpGenericProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getIdpGenericProxy(clientConfig *platformclientv2.Configuration) *idpGenericProxy {
-	if internalProxy == nil {
-		internalProxy = newIdpGenericProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return provider.GetProxy(clientConfig, newIdpGenericProxy)
 }
 
 // getIdpGeneric retrieves all Genesys Cloud idp generic
@@ -3,6 +3,7 @@ package idp_gsuite
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
 )
@@ -42,14 +43,13 @@ func newIdpGsuiteProxy(clientConfig *platformclientv2.Configuration) *idpGsuiteP
 	}
 }
 
-// getIdpGsuiteProxy acts as a singleton to for the internalProxy.  It also ensures
+// getIdpGsuiteProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getIdpGsuiteProxy(clientConfig *platformclientv2.Configuration) *idpGsuiteProxy {
-	if internalProxy == nil {
-		internalProxy = newIdpGsuiteProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return provider.GetProxy(clientConfig, newIdpGsuiteProxy)
 }
 
 // getIdpGsuite retrieves all Genesys Cloud idp gsuite
@@ -3,6 +3,7 @@ package idp_okta
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
 )
@@ -42,14 +43,13 @@ func newIdpOktaProxy(clientConfig *platformclientv2.Configuration) *idpOktaProxy
 	}
 }
 
-// getIdpOktaProxy acts as a singleton to for the internalProxy.  It also ensures
+// getIdpOktaProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getIdpOktaProxy(clientConfig *platformclientv2.Configuration) *idpOktaProxy {
-	if internalProxy == nil {
-		internalProxy = newIdpOktaProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return provider.GetProxy(clientConfig, newIdpOktaProxy)
 }
 
 // getIdpOkta retrieves all Genesys Cloud idp okta
@@ -3,6 +3,7 @@ package idp_onelogin
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
 )
@@ -42,14 +43,13 @@ func newIdpOneloginProxy(clientConfig *platformclientv2.Configuration) *idpOnelo
 	}
 }
 
-// getIdpOneloginProxy acts as a singleton to for the internalProxy.  It also ensures
+// getIdpOneloginProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getIdpOneloginProxy(clientConfig *platformclientv2.Configuration) *idpOneloginProxy {
-	if internalProxy == nil {
-		internalProxy = newIdpOneloginProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return provider.GetProxy(clientConfig, newIdpOneloginProxy)
 }
 
 // getIdpOnelogin retrieves all Genesys Cloud idp onelogin
@@ -3,6 +3,7 @@ package idp_ping
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
 )
@@ -42,14 +43,13 @@ func newIdpPingProxy(clientConfig *platformclientv2.Configuration) *idpPingProxy
 	}
 }
 
-// getIdpPingProxy acts as a singleton to for the internalProxy.  It also ensures
+// getIdpPingProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getIdpPingProxy(clientConfig *platformclientv2.Configuration) *idpPingProxy {
-	if internalProxy == nil {
-		internalProxy = newIdpPingProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return provider.GetProxy(clientConfig, newIdpPingProxy)
 }
 
 // getIdpPing retrieves all Genesys Cloud idp ping
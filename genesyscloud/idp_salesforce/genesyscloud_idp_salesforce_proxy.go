@@ -2,6 +2,7 @@ package idp_salesforce
 
 import (
 	"context"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
 )
@@ -41,14 +42,13 @@ func newIdpSalesforceProxy(clientConfig *platformclientv2.Configuration) *idpSal
 	}
 }
 
-// getIdpSalesforceProxy acts as a singleton to for the internalProxy.  It also ensures
+// getIdpSalesforceProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getIdpSalesforceProxy(clientConfig *platformclientv2.Configuration) *idpSalesforceProxy {
-	if internalProxy == nil {
-		internalProxy = newIdpSalesforceProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return provider.GetProxy(clientConfig, newIdpSalesforceProxy)
 }
 
 // getIdpSalesforce returns a single Genesys Cloud idp salesforce
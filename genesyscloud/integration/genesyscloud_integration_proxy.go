@@ -3,6 +3,7 @@ package integration
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
 )
@@ -72,13 +73,13 @@ func newIntegrationsProxy(clientConfig *platformclientv2.Configuration) *integra
 	}
 }
 
-// getIntegrationsProxy acts as a singleton to for the internalProxy.  It also ensures
+// getIntegrationsProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getIntegrationsProxy(clientConfig *platformclientv2.Configuration) *integrationsProxy {
-	if internalProxy == nil {
-		internalProxy = newIntegrationsProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newIntegrationsProxy)
 }
 
 // getAllIntegrations retrieves all Genesys Cloud Integrations
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
 )
@@ -81,13 +82,13 @@ func newIntegrationActionsProxy(clientConfig *platformclientv2.Configuration) *i
 	}
 }
 
-// getIntegrationActionsProxy acts as a singleton to for the internalProxy.  It also ensures
+// getIntegrationActionsProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getIntegrationActionsProxy(clientConfig *platformclientv2.Configuration) *integrationActionsProxy {
-	if internalProxy == nil {
-		internalProxy = newIntegrationActionsProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newIntegrationActionsProxy)
 }
 
 // getAllIntegrationActions retrieves all Genesys Cloud Integration Actions
@@ -3,6 +3,7 @@ package integration_credential
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
 )
@@ -68,13 +69,13 @@ func newIntegrationCredsProxy(clientConfig *platformclientv2.Configuration) *int
 	}
 }
 
-// getIntegrationCredsProxy acts as a singleton to for the internalProxy.  It also ensures
+// getIntegrationCredsProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getIntegrationCredsProxy(clientConfig *platformclientv2.Configuration) *integrationCredsProxy {
-	if internalProxy == nil {
-		internalProxy = newIntegrationCredsProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newIntegrationCredsProxy)
 }
 
 // getAllIntegrationCredentials retrieves all Genesys Cloud Integrations
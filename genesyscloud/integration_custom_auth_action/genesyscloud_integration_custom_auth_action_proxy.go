@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
 )
@@ -69,13 +70,13 @@ func newCustomAuthActionsProxy(clientConfig *platformclientv2.Configuration) *cu
 	}
 }
 
-// getCustomAuthActionsProxy acts as a singleton to for the internalProxy.  It also ensures
+// getCustomAuthActionsProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getCustomAuthActionsProxy(clientConfig *platformclientv2.Configuration) *customAuthActionsProxy {
-	if internalProxy == nil {
-		internalProxy = newCustomAuthActionsProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newCustomAuthActionsProxy)
 }
 
 // getAllIntegrationCustomAuthActions retrieves all Genesys Cloud Integration Custom Auth Actions
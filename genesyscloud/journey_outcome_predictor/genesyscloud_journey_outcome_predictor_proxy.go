@@ -2,6 +2,7 @@ package journey_outcome_predictor
 
 import (
 	"context"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
 )
@@ -44,14 +45,13 @@ func newJourneyOutcomePredictorProxy(clientConfig *platformclientv2.Configuratio
 	}
 }
 
-// getJourneyOutcomePredictorProxy acts as a singleton to for the internalProxy.  It also ensures
+// getJourneyOutcomePredictorProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getJourneyOutcomePredictorProxy(clientConfig *platformclientv2.Configuration) *journeyOutcomePredictorProxy {
-	if internalProxy == nil {
-		internalProxy = newJourneyOutcomePredictorProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return provider.GetProxy(clientConfig, newJourneyOutcomePredictorProxy)
 }
 
 // createJourneyOutcomePredictor creates a Genesys Cloud journey outcome predictor
@@ -2,6 +2,7 @@ package journey_views
 
 import (
 	"context"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
@@ -39,10 +40,10 @@ func newJourneyViewsProxy(clientConfig *platformclientv2.Configuration) *journey
 }
 
 func getJourneyViewProxy(clientConfig *platformclientv2.Configuration) *journeyViewsProxy {
-	if internalProxy == nil {
-		internalProxy = newJourneyViewsProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newJourneyViewsProxy)
 }
 
 func (p *journeyViewsProxy) getJourneyViewById(ctx context.Context, viewId string) (*platformclientv2.Journeyview, *platformclientv2.APIResponse, error) {
@@ -3,6 +3,7 @@ package location
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
@@ -46,14 +47,13 @@ func newLocationProxy(clientConfig *platformclientv2.Configuration) *locationPro
 	}
 }
 
-// getLocationProxy acts as a singleton to for the internalProxy.  It also ensures
+// getLocationProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getLocationProxy(clientConfig *platformclientv2.Configuration) *locationProxy {
-	if internalProxy == nil {
-		internalProxy = newLocationProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return provider.GetProxy(clientConfig, newLocationProxy)
 }
 
 func (p *locationProxy) getAllLocation(ctx context.Context) (*[]platformclientv2.Locationdefinition, *platformclientv2.APIResponse, error) {
@@ -105,7 +105,7 @@ func updateLocation(ctx context.Context, d *schema.ResourceData, meta interface{
 
 	log.Printf("Updating location %s", name)
 
-	if diagErr := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+	if diagErr := util.RetryWhen(ctx, util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 		// Get current location version
 		location, resp, getErr := proxy.getLocationById(ctx, d.Id(), nil)
 		if getErr != nil {
@@ -156,7 +156,7 @@ func deleteLocation(ctx context.Context, d *schema.ResourceData, meta interface{
 
 	log.Printf("Deleting location %s", name)
 
-	if diagErr := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+	if diagErr := util.RetryWhen(ctx, util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 		// Directory occasionally returns version errors on deletes if an object was updated at the same time.
 		resp, err := proxy.deleteLocation(ctx, d.Id())
 		if err != nil {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"log"
 	"sync"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
 )
@@ -90,10 +91,10 @@ into the integration credential object.  Today the integration credential object
 without because once the oauth client is created, we dont want to expose the secret.
 */
 func GetOAuthClientProxy(clientConfig *platformclientv2.Configuration) *oauthClientProxy {
-	if internalProxy == nil {
-		internalProxy = newOAuthClientProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newOAuthClientProxy)
 }
 
 func (o *oauthClientProxy) deleteOAuthClient(ctx context.Context, id string) (*platformclientv2.APIResponse, error) {
@@ -3,6 +3,7 @@ package organization_authentication_settings
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
 )
@@ -39,13 +40,13 @@ func newOrgAuthSettingsProxy(clientConfig *platformclientv2.Configuration) *orgA
 	}
 }
 
-// getOrgAuthSettingsProxy acts as a singleton to for the internalProxy.  It also ensures
+// getOrgAuthSettingsProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getOrgAuthSettingsProxy(clientConfig *platformclientv2.Configuration) *orgAuthSettingsProxy {
-	if internalProxy == nil {
-		internalProxy = newOrgAuthSettingsProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newOrgAuthSettingsProxy)
 }
 
 // getOrgAuthSettings returns a single Genesys Cloud organization authentication settings by Id
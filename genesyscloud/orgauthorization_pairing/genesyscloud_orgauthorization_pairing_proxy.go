@@ -3,6 +3,7 @@ package orgauthorization_pairing
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
 )
@@ -32,13 +33,13 @@ func newOrgauthorizationPairingProxy(clientConfig *platformclientv2.Configuratio
 	}
 }
 
-// getOrgauthorizationPairingProxy acts as a singleton to for the internalProxy.  It also ensures
+// getOrgauthorizationPairingProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getOrgauthorizationPairingProxy(clientConfig *platformclientv2.Configuration) *orgauthorizationPairingProxy {
-	if internalProxy == nil {
-		internalProxy = newOrgauthorizationPairingProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newOrgauthorizationPairingProxy)
 }
 
 // createOrgauthorizationPairing creates a Genesys Cloud orgauthorization pairing
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
 )
@@ -53,11 +54,10 @@ func newOutboundCallableTimesetProxy(clientConfig *platformclientv2.Configuratio
 }
 
 func getOutboundCallabletimesetProxy(clientConfig *platformclientv2.Configuration) *outboundCallableTimesetProxy {
-	if internalProxy == nil {
-		internalProxy = newOutboundCallableTimesetProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return provider.GetProxy(clientConfig, newOutboundCallableTimesetProxy)
 }
 
 // createOutboundCallabletimeset creates a Genesys Cloud Outbound Callable Timeset
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
 )
@@ -52,13 +53,13 @@ func newOutboundCallanalysisresponsesetProxy(clientConfig *platformclientv2.Conf
 	}
 }
 
-// getOutboundCallanalysisresponsesetProxy acts as a singleton to for the internalProxy.  It also ensures
+// getOutboundCallanalysisresponsesetProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getOutboundCallanalysisresponsesetProxy(clientConfig *platformclientv2.Configuration) *outboundCallanalysisresponsesetProxy {
-	if internalProxy == nil {
-		internalProxy = newOutboundCallanalysisresponsesetProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newOutboundCallanalysisresponsesetProxy)
 }
 
 // createOutboundCallanalysisresponseset creates a Genesys Cloud outbound callanalysisresponseset
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 	"terraform-provider-genesyscloud/genesyscloud/util"
 	"time"
@@ -61,13 +62,13 @@ func newOutboundCampaignProxy(clientConfig *platformclientv2.Configuration) *out
 	}
 }
 
-// getOutboundCampaignProxy acts as a singleton to for the internalProxy.  It also ensures
+// getOutboundCampaignProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getOutboundCampaignProxy(clientConfig *platformclientv2.Configuration) *outboundCampaignProxy {
-	if internalProxy == nil {
-		internalProxy = newOutboundCampaignProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newOutboundCampaignProxy)
 }
 
 // createOutboundCampaign creates a Genesys Cloud outbound campaign
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
 )
@@ -52,13 +53,13 @@ func newOutboundCampaignruleProxy(clientConfig *platformclientv2.Configuration)
 	}
 }
 
-// getOutboundCampaignruleProxy acts as a singleton to for the internalProxy.  It also ensures
+// getOutboundCampaignruleProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getOutboundCampaignruleProxy(clientConfig *platformclientv2.Configuration) *outboundCampaignruleProxy {
-	if internalProxy == nil {
-		internalProxy = newOutboundCampaignruleProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newOutboundCampaignruleProxy)
 }
 
 // createOutboundCampaignrule creates a Genesys Cloud outbound campaignrule
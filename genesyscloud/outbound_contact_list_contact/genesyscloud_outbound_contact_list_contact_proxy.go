@@ -2,6 +2,7 @@ package outbound_contact_list_contact
 
 import (
 	"context"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
@@ -42,11 +43,10 @@ func newContactProxy(clientConfig *platformclientv2.Configuration) *contactProxy
 }
 
 func getContactProxy(clientConfig *platformclientv2.Configuration) *contactProxy {
-	if internalProxy == nil {
-		internalProxy = newContactProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return provider.GetProxy(clientConfig, newContactProxy)
 }
 
 func (p *contactProxy) createContact(ctx context.Context, contactListId string, contact platformclientv2.Writabledialercontact, priority, clearSystemData, doNotQueue bool) ([]platformclientv2.Dialercontact, *platformclientv2.APIResponse, error) {
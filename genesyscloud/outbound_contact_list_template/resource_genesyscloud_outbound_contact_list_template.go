@@ -112,7 +112,7 @@ func updateOutboundContactListTemplate(ctx context.Context, d *schema.ResourceDa
 	}
 
 	log.Printf("Updating Outbound Contact List Template %s", name)
-	diagErr := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+	diagErr := util.RetryWhen(ctx, util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 
 		_, resp, updateErr := proxy.updateOutboundContactlisttemplate(ctx, d.Id(), &sdkContactListTemplate)
 		if updateErr != nil {
@@ -192,7 +192,7 @@ func deleteOutboundContactListTemplate(ctx context.Context, d *schema.ResourceDa
 	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
 	proxy := getOutboundContactlisttemplateProxy(sdkConfig)
 
-	diagErr := util.RetryWhen(util.IsStatus400, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+	diagErr := util.RetryWhen(ctx, util.IsStatus400, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 		log.Printf("Deleting Outbound Contact List Template")
 		resp, err := proxy.deleteOutboundContactlisttemplate(ctx, d.Id())
 		if err != nil {
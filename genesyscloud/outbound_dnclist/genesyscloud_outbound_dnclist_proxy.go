@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	"terraform-provider-genesyscloud/genesyscloud/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -51,10 +52,10 @@ func newOutboundDnclistProxy(clientConfig *platformclientv2.Configuration) *outb
 }
 
 func getOutboundDnclistProxy(clientConfig *platformclientv2.Configuration) *outboundDnclistProxy {
-	if internalProxy == nil {
-		internalProxy = newOutboundDnclistProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newOutboundDnclistProxy)
 }
 
 // createOutboundDnclist creates a Genesys Cloud Outbound Dnclist
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
 )
@@ -53,14 +54,13 @@ func newOutboundFilespecificationtemplateProxy(clientConfig *platformclientv2.Co
 	}
 }
 
-// getOutboundFilespecificationtemplateProxy acts as a singleton to for the internalProxy. It also ensures
+// getOutboundFilespecificationtemplateProxy returns the proxy of the provider instance that owns the client config. It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getOutboundFilespecificationtemplateProxy(clientConfig *platformclientv2.Configuration) *outboundFilespecificationtemplateProxy {
-	if internalProxy == nil {
-		internalProxy = newOutboundFilespecificationtemplateProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return provider.GetProxy(clientConfig, newOutboundFilespecificationtemplateProxy)
 }
 
 // createOutboundFilespecificationtemplate creates a Genesys Cloud outbound filespecificationtemplate
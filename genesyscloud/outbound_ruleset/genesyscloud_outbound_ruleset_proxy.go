@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
 )
@@ -53,13 +54,13 @@ func newOutboundRulesetProxy(clientConfig *platformclientv2.Configuration) *outb
 	}
 }
 
-// getOutboundRulesetProxy acts as a singleton to for the internalProxy.  It also ensures
+// getOutboundRulesetProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getOutboundRulesetProxy(clientConfig *platformclientv2.Configuration) *outboundRulesetProxy {
-	if internalProxy == nil {
-		internalProxy = newOutboundRulesetProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newOutboundRulesetProxy)
 }
 
 // createOutboundRuleset creates a Genesys Cloud Outbound Ruleset
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
 )
@@ -52,13 +53,13 @@ func newOutboundSequenceProxy(clientConfig *platformclientv2.Configuration) *out
 	}
 }
 
-// getOutboundSequenceProxy acts as a singleton to for the internalProxy.  It also ensures
+// getOutboundSequenceProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getOutboundSequenceProxy(clientConfig *platformclientv2.Configuration) *outboundSequenceProxy {
-	if internalProxy == nil {
-		internalProxy = newOutboundSequenceProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newOutboundSequenceProxy)
 }
 
 // createOutboundSequence creates a Genesys Cloud outbound sequence
@@ -3,6 +3,7 @@ package outbound_settings
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
 )
@@ -39,13 +40,13 @@ func newOutboundSettingsProxy(clientConfig *platformclientv2.Configuration) *out
 	}
 }
 
-// getOutboundSettingsProxy acts as a singleton to for the internalProxy.  It also ensures
+// getOutboundSettingsProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getOutboundSettingsProxy(clientConfig *platformclientv2.Configuration) *outboundSettingsProxy {
-	if internalProxy == nil {
-		internalProxy = newOutboundSettingsProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newOutboundSettingsProxy)
 }
 
 // getOutboundSettings returns a single Genesys Cloud outbound settings by Id
@@ -109,7 +109,7 @@ func updateOutboundSettings(ctx context.Context, d *schema.ResourceData, meta in
 
 	log.Printf("Updating Outbound Settings %s", d.Id())
 
-	diagErr := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+	diagErr := util.RetryWhen(ctx, util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 		// Get current Outbound settings version
 		setting, resp, getErr := proxy.getOutboundSettings(ctx)
 		if getErr != nil {
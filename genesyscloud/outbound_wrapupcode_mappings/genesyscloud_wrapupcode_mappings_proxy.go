@@ -3,6 +3,7 @@ package outbound_wrapupcode_mappings
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
 )
@@ -38,10 +39,10 @@ func newOutboundWrapupCodeMappingsProxy(clientConfig *platformclientv2.Configura
 
 // etOutboundWrapupCodeMappingsProxy is a singleton method to return a single instance outboundWrapupCodeMappingsProxy
 func getOutboundWrapupCodeMappingsProxy(clientConfig *platformclientv2.Configuration) *outboundWrapupCodeMappingsProxy {
-	if internalProxy == nil {
-		internalProxy = newOutboundWrapupCodeMappingsProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newOutboundWrapupCodeMappingsProxy)
 }
 
 // getAllOutboundWrapupCodeMapping returns all of the outbound mapping.  This is the struct implementation that should be consumed by everypne.
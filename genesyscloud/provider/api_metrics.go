@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"net/http"
+	"sync/atomic"
+)
+
+// APIRequestCounts counts the requests made by all SDK clients of the provider
+type APIRequestCounts struct {
+	Requests    int64 `json:"requests"`
+	Retries     int64 `json:"retries"`
+	RateLimited int64 `json:"rate_limited"`
+}
+
+var (
+	apiRequests    atomic.Int64
+	apiRetries     atomic.Int64
+	apiRateLimited atomic.Int64
+)
+
+// GetAPIRequestCounts returns the number of requests made since the provider was started
+func GetAPIRequestCounts() APIRequestCounts {
+	return APIRequestCounts{
+		Requests:    apiRequests.Load(),
+		Retries:     apiRetries.Load(),
+		RateLimited: apiRateLimited.Load(),
+	}
+}
+
+// Sub returns the number of requests made between two calls to GetAPIRequestCounts
+func (c APIRequestCounts) Sub(start APIRequestCounts) APIRequestCounts {
+	return APIRequestCounts{
+		Requests:    c.Requests - start.Requests,
+		Retries:     c.Retries - start.Retries,
+		RateLimited: c.RateLimited - start.RateLimited,
+	}
+}
+
+// countRequest is called before every attempt of a request. Attempts after the first are counted as retries.
+func countRequest(attempt int) {
+	apiRequests.Add(1)
+	if attempt > 0 {
+		apiRetries.Add(1)
+	}
+}
+
+func countResponse(response *http.Response) {
+	if response.StatusCode == http.StatusTooManyRequests {
+		apiRateLimited.Add(1)
+	}
+}
@@ -0,0 +1,344 @@
+package provider
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
+)
+
+/*
+This file contains the API telemetry of the provider. When the api_telemetry_file provider setting is set, every API request is
+attributed to the resource type and operation that made it, and the number of requests, bytes, status codes and latency are
+aggregated per resource type and operation. The operations are labelled by wrapping the CRUD functions of every resource and data
+source, and the label is attached to the pooled client config while the operation holds it, so the request hooks of the client
+config know which operation sent a request. The report is written as JSON, or as CSV when the file has a .csv extension,
+periodically while the provider runs and when it stops.
+*/
+
+const (
+	OperationCreate = "create"
+	OperationRead   = "read"
+	OperationUpdate = "update"
+	OperationDelete = "delete"
+	// OperationDataSource is the read of a data source
+	OperationDataSource = "data_source"
+	// OperationList is the listing of all objects of a resource type by the exporter
+	OperationList = "list"
+)
+
+// Requests sent by client configs that are not held by an operation, e.g. while the provider is configured
+var unattributedLabel = apiTelemetryLabel{ResourceType: "provider", Operation: "other"}
+
+// How often the report is written while the provider runs
+var apiTelemetryFlushInterval = 30 * time.Second
+
+type apiTelemetryLabel struct {
+	ResourceType string
+	Operation    string
+}
+
+type apiTelemetryLabelKey struct{}
+
+// apiOperationStats are the aggregated requests of an operation of a resource type
+type apiOperationStats struct {
+	ResourceType        string           `json:"resource_type"`
+	Operation           string           `json:"operation"`
+	Invocations         int64            `json:"invocations"`
+	DurationSeconds     float64          `json:"duration_seconds"`
+	Requests            int64            `json:"requests"`
+	RequestBytes        int64            `json:"request_bytes"`
+	ResponseBytes       int64            `json:"response_bytes"`
+	StatusCodes         map[string]int64 `json:"status_codes"`
+	TotalLatencySeconds float64          `json:"total_latency_seconds"`
+	MaxLatencySeconds   float64          `json:"max_latency_seconds"`
+}
+
+type apiTelemetryReport struct {
+	StartTime  time.Time            `json:"start_time"`
+	EndTime    time.Time            `json:"end_time"`
+	Operations []*apiOperationStats `json:"operations"`
+}
+
+type inFlightRequest struct {
+	label apiTelemetryLabel
+	start time.Time
+}
+
+type apiTelemetry struct {
+	enabled   atomic.Bool
+	flushOnce sync.Once
+
+	mutex     sync.Mutex
+	path      string
+	startTime time.Time
+	stats     map[apiTelemetryLabel]*apiOperationStats
+	dirty     bool
+
+	// The label of the operation holding each client config, and the start of every request that has not been answered yet
+	clientLabels sync.Map
+	inFlight     sync.Map
+}
+
+var telemetry = &apiTelemetry{stats: make(map[apiTelemetryLabel]*apiOperationStats)}
+
+// enableAPITelemetry starts collecting telemetry for the report at the path. The path of the first provider config that
+// enables telemetry is used for the whole provider run.
+func enableAPITelemetry(path string) {
+	if path == "" {
+		return
+	}
+	telemetry.mutex.Lock()
+	if telemetry.path == "" {
+		telemetry.path = path
+		telemetry.startTime = time.Now()
+	}
+	telemetry.mutex.Unlock()
+	telemetry.enabled.Store(true)
+
+	telemetry.flushOnce.Do(func() {
+		go func() {
+			for range time.Tick(apiTelemetryFlushInterval) {
+				if err := WriteAPITelemetryReport(); err != nil {
+					log.Printf("Failed to write API telemetry report: %v", err)
+				}
+			}
+		}()
+	})
+}
+
+// WithAPITelemetryLabel returns a copy of the context that attributes the requests made with it to an operation of a resource type
+func WithAPITelemetryLabel(ctx context.Context, resourceType string, operation string) context.Context {
+	return context.WithValue(ctx, apiTelemetryLabelKey{}, apiTelemetryLabel{ResourceType: resourceType, Operation: operation})
+}
+
+func apiTelemetryLabelFromContext(ctx context.Context) apiTelemetryLabel {
+	if label, ok := ctx.Value(apiTelemetryLabelKey{}).(apiTelemetryLabel); ok {
+		return label
+	}
+	return unattributedLabel
+}
+
+// withAPITelemetry returns a copy of a resource whose CRUD functions label their context for the telemetry and record how long
+// they take. The context is labelled even when the telemetry is disabled, as the label also names the resource type in the
+// diagnostics of the read-only mode.
+func withAPITelemetry(resourceType string, resource *schema.Resource, isDataSource bool) *schema.Resource {
+	wrapped := *resource
+	wrap := func(operation string, method schema.ReadContextFunc) schema.ReadContextFunc {
+		if method == nil {
+			return nil
+		}
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			ctx = WithAPITelemetryLabel(ctx, resourceType, operation)
+			if !telemetry.enabled.Load() {
+				return method(ctx, d, meta)
+			}
+			start := time.Now()
+			defer func() {
+				telemetry.recordInvocation(apiTelemetryLabel{ResourceType: resourceType, Operation: operation}, time.Since(start))
+			}()
+			return method(ctx, d, meta)
+		}
+	}
+
+	if isDataSource {
+		wrapped.ReadContext = wrap(OperationDataSource, resource.ReadContext)
+		wrapped.ReadWithoutTimeout = wrap(OperationDataSource, resource.ReadWithoutTimeout)
+		return &wrapped
+	}
+	wrapped.CreateContext = schema.CreateContextFunc(wrap(OperationCreate, schema.ReadContextFunc(resource.CreateContext)))
+	wrapped.ReadContext = wrap(OperationRead, resource.ReadContext)
+	wrapped.ReadWithoutTimeout = wrap(OperationRead, resource.ReadWithoutTimeout)
+	wrapped.UpdateContext = schema.UpdateContextFunc(wrap(OperationUpdate, schema.ReadContextFunc(resource.UpdateContext)))
+	wrapped.DeleteContext = schema.DeleteContextFunc(wrap(OperationDelete, schema.ReadContextFunc(resource.DeleteContext)))
+	return &wrapped
+}
+
+// labelClient attributes the requests of a client config to the operation of the context until unlabelClient is called
+func labelClient(clientConfig *platformclientv2.Configuration, ctx context.Context) {
+	if telemetry.enabled.Load() {
+		telemetry.clientLabels.Store(clientConfig, apiTelemetryLabelFromContext(ctx))
+	}
+}
+
+func unlabelClient(clientConfig *platformclientv2.Configuration) {
+	telemetry.clientLabels.Delete(clientConfig)
+}
+
+// requestStarted is called before every attempt of a request made by a client config
+func (t *apiTelemetry) requestStarted(clientConfig *platformclientv2.Configuration, request *http.Request) {
+	if !t.enabled.Load() || request == nil {
+		return
+	}
+	label := unattributedLabel
+	if value, ok := t.clientLabels.Load(clientConfig); ok {
+		label = value.(apiTelemetryLabel)
+	}
+	t.inFlight.Store(request, &inFlightRequest{label: label, start: time.Now()})
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	stats := t.operationStats(label)
+	stats.Requests++
+	if request.ContentLength > 0 {
+		stats.RequestBytes += request.ContentLength
+	}
+	t.dirty = true
+}
+
+// responseReceived records the status and latency of a request. The bytes of the response body are counted as it is read.
+func (t *apiTelemetry) responseReceived(response *http.Response) {
+	if !t.enabled.Load() || response == nil {
+		return
+	}
+	value, ok := t.inFlight.LoadAndDelete(response.Request)
+	if !ok {
+		return
+	}
+	request := value.(*inFlightRequest)
+	latency := time.Since(request.start).Seconds()
+
+	t.mutex.Lock()
+	stats := t.operationStats(request.label)
+	stats.StatusCodes[strconv.Itoa(response.StatusCode)]++
+	stats.TotalLatencySeconds += latency
+	if latency > stats.MaxLatencySeconds {
+		stats.MaxLatencySeconds = latency
+	}
+	t.dirty = true
+	t.mutex.Unlock()
+
+	if response.Body != nil {
+		response.Body = &countingReadCloser{ReadCloser: response.Body, label: request.label}
+	}
+}
+
+func (t *apiTelemetry) recordInvocation(label apiTelemetryLabel, duration time.Duration) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	stats := t.operationStats(label)
+	stats.Invocations++
+	stats.DurationSeconds += duration.Seconds()
+	t.dirty = true
+}
+
+// operationStats must be called with the mutex held
+func (t *apiTelemetry) operationStats(label apiTelemetryLabel) *apiOperationStats {
+	stats, ok := t.stats[label]
+	if !ok {
+		stats = &apiOperationStats{ResourceType: label.ResourceType, Operation: label.Operation, StatusCodes: make(map[string]int64)}
+		t.stats[label] = stats
+	}
+	return stats
+}
+
+// countingReadCloser counts the bytes of a response body that are read
+type countingReadCloser struct {
+	io.ReadCloser
+	label apiTelemetryLabel
+}
+
+func (c *countingReadCloser) Read(p []byte) (int, error) {
+	n, err := c.ReadCloser.Read(p)
+	if n > 0 {
+		telemetry.mutex.Lock()
+		telemetry.operationStats(c.label).ResponseBytes += int64(n)
+		telemetry.mutex.Unlock()
+	}
+	return n, err
+}
+
+// WriteAPITelemetryReport writes the telemetry collected so far when the api_telemetry_file provider setting is set
+func WriteAPITelemetryReport() error {
+	return telemetry.write(time.Now())
+}
+
+func (t *apiTelemetry) write(now time.Time) error {
+	t.mutex.Lock()
+	if t.path == "" || !t.dirty {
+		t.mutex.Unlock()
+		return nil
+	}
+	report := &apiTelemetryReport{StartTime: t.startTime, EndTime: now}
+	for _, stats := range t.stats {
+		statsCopy := *stats
+		statsCopy.StatusCodes = make(map[string]int64, len(stats.StatusCodes))
+		for code, count := range stats.StatusCodes {
+			statsCopy.StatusCodes[code] = count
+		}
+		report.Operations = append(report.Operations, &statsCopy)
+	}
+	path := t.path
+	t.dirty = false
+	t.mutex.Unlock()
+
+	sort.Slice(report.Operations, func(i, j int) bool {
+		if report.Operations[i].ResourceType != report.Operations[j].ResourceType {
+			return report.Operations[i].ResourceType < report.Operations[j].ResourceType
+		}
+		return report.Operations[i].Operation < report.Operations[j].Operation
+	})
+
+	var data []byte
+	var err error
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		data, err = report.csv()
+	} else {
+		data, err = json.MarshalIndent(report, "", "  ")
+	}
+	if err != nil {
+		return err
+	}
+	if dir := filepath.Dir(path); dir != "" {
+		if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+			return err
+		}
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// csv returns the report with a row for every operation. The status codes are written as code=count pairs separated by spaces.
+func (r *apiTelemetryReport) csv() ([]byte, error) {
+	var builder strings.Builder
+	writer := csv.NewWriter(&builder)
+	rows := [][]string{{"resource_type", "operation", "invocations", "duration_seconds", "requests", "request_bytes", "response_bytes",
+		"status_codes", "total_latency_seconds", "max_latency_seconds"}}
+	for _, stats := range r.Operations {
+		codes := make([]string, 0, len(stats.StatusCodes))
+		for code, count := range stats.StatusCodes {
+			codes = append(codes, fmt.Sprintf("%s=%d", code, count))
+		}
+		sort.Strings(codes)
+		rows = append(rows, []string{
+			stats.ResourceType,
+			stats.Operation,
+			strconv.FormatInt(stats.Invocations, 10),
+			strconv.FormatFloat(stats.DurationSeconds, 'f', 3, 64),
+			strconv.FormatInt(stats.Requests, 10),
+			strconv.FormatInt(stats.RequestBytes, 10),
+			strconv.FormatInt(stats.ResponseBytes, 10),
+			strings.Join(codes, " "),
+			strconv.FormatFloat(stats.TotalLatencySeconds, 'f', 3, 64),
+			strconv.FormatFloat(stats.MaxLatencySeconds, 'f', 3, 64),
+		})
+	}
+	if err := writer.WriteAll(rows); err != nil {
+		return nil, err
+	}
+	return []byte(builder.String()), nil
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestUnitAPITelemetryReport verifies requests are attributed to the operation holding the client config and reported as JSON and CSV
+func TestUnitAPITelemetryReport(t *testing.T) {
+	previous := telemetry
+	telemetry = &apiTelemetry{stats: make(map[apiTelemetryLabel]*apiOperationStats)}
+	defer func() { telemetry = previous }()
+	telemetry.enabled.Store(true)
+
+	clientConfig := platformclientv2.NewConfiguration()
+	resource := withAPITelemetry("genesyscloud_routing_queue", &schema.Resource{
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			labelClient(clientConfig, ctx)
+			defer unlabelClient(clientConfig)
+
+			request := httptest.NewRequest(http.MethodPost, "/api/v2/routing/queues", strings.NewReader(`{"name": "queue"}`))
+			telemetry.requestStarted(clientConfig, request)
+			response := &http.Response{StatusCode: http.StatusOK, Request: request, Body: io.NopCloser(strings.NewReader(`{"id": "1"}`))}
+			telemetry.responseReceived(response)
+			_, _ = io.ReadAll(response.Body)
+			return nil
+		},
+	}, false)
+	assert.Nil(t, resource.CreateContext(context.Background(), nil, nil))
+
+	// Requests of client configs that are not held by an operation are not attributed to it
+	telemetry.requestStarted(clientConfig, httptest.NewRequest(http.MethodGet, "/api/v2/organizations/me", nil))
+
+	jsonPath := filepath.Join(t.TempDir(), "telemetry.json")
+	telemetry.path = jsonPath
+	if err := telemetry.write(time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(jsonPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	report := &apiTelemetryReport{}
+	if err := json.Unmarshal(data, report); err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, report.Operations, 2)
+	create := report.Operations[0]
+	assert.Equal(t, "genesyscloud_routing_queue", create.ResourceType)
+	assert.Equal(t, OperationCreate, create.Operation)
+	assert.Equal(t, int64(1), create.Invocations)
+	assert.Equal(t, int64(1), create.Requests)
+	assert.Equal(t, int64(17), create.RequestBytes)
+	assert.Equal(t, int64(11), create.ResponseBytes)
+	assert.Equal(t, map[string]int64{"200": 1}, create.StatusCodes)
+	assert.Equal(t, unattributedLabel.ResourceType, report.Operations[1].ResourceType)
+
+	csvPath := filepath.Join(t.TempDir(), "telemetry.csv")
+	telemetry.path = csvPath
+	telemetry.dirty = true
+	if err := telemetry.write(time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	data, err = os.ReadFile(csvPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	assert.Len(t, lines, 3)
+	assert.True(t, strings.HasPrefix(lines[1], "genesyscloud_routing_queue,create,1,"))
+	assert.Contains(t, lines[1], ",200=1,")
+}
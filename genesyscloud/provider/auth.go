@@ -0,0 +1,396 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"regexp"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
+)
+
+/*
+This file contains the logic to authorize the SDK clients of the provider. Besides a static access token, a client config can be
+authorized with the client credentials, SAML2 bearer or JWT bearer grant. The credentials are taken from the provider config, and
+any credential that isn't set there can be read from a JSON credentials file or from the output of a credentials helper command, so
+secrets don't have to be stored in the config or in environment variables. Tokens can be cached on disk so that provider runs,
+e.g. parallel terraform plans, reuse the tokens of previous runs rather than each requesting new ones.
+*/
+
+const (
+	grantClientCredentials = "client_credentials"
+	grantSaml2Bearer       = "urn:ietf:params:oauth:grant-type:saml2-bearer"
+	grantJwtBearer         = "urn:ietf:params:oauth:grant-type:jwt-bearer"
+
+	// Tokens are refreshed this long before they expire
+	tokenRefreshMargin = time.Minute
+
+	// How long the output of a credentials helper is reused by the client configs of a provider run
+	credentialsHelperTTL = time.Minute
+)
+
+var authHostRegex = regexp.MustCompile(`(?i)\/\/api\.`)
+
+// authCredentials are the credentials used to authorize a client config. The JSON field names are used by credentials files
+// and helpers.
+type authCredentials struct {
+	AccessToken       string `json:"access_token,omitempty"`
+	OAuthClientId     string `json:"oauthclient_id,omitempty"`
+	OAuthClientSecret string `json:"oauthclient_secret,omitempty"`
+	Saml2Assertion    string `json:"saml2_assertion,omitempty"`
+	OrgName           string `json:"org_name,omitempty"`
+	JwtAssertion      string `json:"jwt_assertion,omitempty"`
+}
+
+// loadCredentials returns the credentials of the provider config. Credentials that aren't set in the config are read from
+// the credentials file or helper.
+func loadCredentials(data *schema.ResourceData) (*authCredentials, error) {
+	credentials := &authCredentials{
+		AccessToken:       data.Get("access_token").(string),
+		OAuthClientId:     data.Get("oauthclient_id").(string),
+		OAuthClientSecret: data.Get("oauthclient_secret").(string),
+		Saml2Assertion:    data.Get("saml2_assertion").(string),
+		OrgName:           data.Get("org_name").(string),
+		JwtAssertion:      data.Get("jwt_assertion").(string),
+	}
+
+	var external *authCredentials
+	var err error
+	if path := data.Get("credentials_file").(string); path != "" {
+		external, err = readCredentialsFile(path)
+	} else if command := data.Get("credentials_helper").(string); command != "" {
+		external, err = runCredentialsHelper(command)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if external != nil {
+		credentials.merge(external)
+	}
+	return credentials, nil
+}
+
+// merge sets the credentials that are empty to the values of other
+func (c *authCredentials) merge(other *authCredentials) {
+	setIfEmpty := func(value *string, otherValue string) {
+		if *value == "" {
+			*value = otherValue
+		}
+	}
+	setIfEmpty(&c.AccessToken, other.AccessToken)
+	setIfEmpty(&c.OAuthClientId, other.OAuthClientId)
+	setIfEmpty(&c.OAuthClientSecret, other.OAuthClientSecret)
+	setIfEmpty(&c.Saml2Assertion, other.Saml2Assertion)
+	setIfEmpty(&c.OrgName, other.OrgName)
+	setIfEmpty(&c.JwtAssertion, other.JwtAssertion)
+}
+
+// grantType returns the OAuth grant used to request a token with the credentials
+func (c *authCredentials) grantType() string {
+	switch {
+	case c.Saml2Assertion != "":
+		return grantSaml2Bearer
+	case c.JwtAssertion != "":
+		return grantJwtBearer
+	default:
+		return grantClientCredentials
+	}
+}
+
+func (c *authCredentials) formParams() (url.Values, error) {
+	grantType := c.grantType()
+	formParams := url.Values{"grant_type": []string{grantType}}
+	switch grantType {
+	case grantSaml2Bearer:
+		if c.OrgName == "" {
+			return nil, fmt.Errorf("org_name is required to authorize with a SAML2 bearer assertion")
+		}
+		formParams.Set("assertion", c.Saml2Assertion)
+		formParams.Set("orgName", c.OrgName)
+	case grantJwtBearer:
+		formParams.Set("assertion", c.JwtAssertion)
+	}
+	return formParams, nil
+}
+
+// cacheKey identifies the tokens issued for the credentials in the token cache. Tokens issued for an assertion are only reused
+// for the same assertion, as assertions of different subjects can share the OAuth client and org.
+func (c *authCredentials) cacheKey(basePath string) string {
+	hash := sha256.New()
+	for _, value := range []string{basePath, c.grantType(), c.OAuthClientId, c.OAuthClientSecret, c.OrgName, c.Saml2Assertion, c.JwtAssertion} {
+		hash.Write([]byte(value))
+		hash.Write([]byte{0})
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func readCredentialsFile(path string) (*authCredentials, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read credentials file %s: %v", path, err)
+	}
+	credentials := &authCredentials{}
+	if err := json.Unmarshal(data, credentials); err != nil {
+		return nil, fmt.Errorf("failed to parse credentials file %s: %v", path, err)
+	}
+	return credentials, nil
+}
+
+type credentialsHelperResult struct {
+	credentials *authCredentials
+	expiresAt   time.Time
+}
+
+var (
+	credentialsHelperResults = make(map[string]*credentialsHelperResult)
+	credentialsHelperMutex   sync.Mutex
+)
+
+// runCredentialsHelper runs a command that writes credentials as JSON to stdout. The client configs of a provider run are
+// authorized concurrently, so the output of a command is reused for a short time rather than running it for every client.
+func runCredentialsHelper(command string) (*authCredentials, error) {
+	credentialsHelperMutex.Lock()
+	defer credentialsHelperMutex.Unlock()
+	if result, ok := credentialsHelperResults[command]; ok && time.Now().Before(result.expiresAt) {
+		return result.credentials, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, "sh", "-c", command)
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", command)
+	}
+	cmd.Stderr = os.Stderr
+
+	log.Print("Running credentials helper")
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("credentials helper failed: %v", err)
+	}
+	credentials := &authCredentials{}
+	if err := json.Unmarshal(output, credentials); err != nil {
+		return nil, fmt.Errorf("failed to parse the output of the credentials helper: %v", err)
+	}
+
+	credentialsHelperResults[command] = &credentialsHelperResult{credentials: credentials, expiresAt: time.Now().Add(credentialsHelperTTL)}
+	return credentials, nil
+}
+
+// clientToken holds the access token of a client config. Tokens are refreshed by the requests of the client config while it is in
+// use, so the token is swapped here under a lock rather than in the AccessToken of the config, which the SDK reads without one.
+// Requests are sent with the token held here, and the AccessToken of pooled client configs is updated when they are acquired.
+// As tokens are only refreshed when a request is sent, client configs that are no longer used never request a token.
+type clientToken struct {
+	mutex     sync.RWMutex
+	value     string
+	expiresAt time.Time
+
+	// refreshMutex serializes refreshes, so the requests rejected with the same token only request one new token
+	refreshMutex sync.Mutex
+	refresh      func() (*cachedToken, diag.Diagnostics)
+}
+
+// clientTokens maps the client configs that are authorized with refreshable credentials to their token
+var clientTokens sync.Map
+
+func (t *clientToken) get() string {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	return t.value
+}
+
+func (t *clientToken) set(value string, expiresAt time.Time) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.value = value
+	t.expiresAt = expiresAt
+}
+
+// expiring returns true when the token expires within the refresh margin. Tokens without a known expiry never expire.
+func (t *clientToken) expiring(now time.Time) bool {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	return !t.expiresAt.IsZero() && now.After(t.expiresAt.Add(-tokenRefreshMargin))
+}
+
+// refreshIfCurrent requests a new token unless the token has changed since it was rejected or found to be expiring
+func (t *clientToken) refreshIfCurrent(current string) diag.Diagnostics {
+	t.refreshMutex.Lock()
+	defer t.refreshMutex.Unlock()
+	if current != t.get() {
+		return nil
+	}
+	token, diagErr := t.refresh()
+	if diagErr != nil {
+		return diagErr
+	}
+	t.set(token.AccessToken, token.ExpiresAt)
+	return nil
+}
+
+func getClientToken(config *platformclientv2.Configuration) *clientToken {
+	if token, ok := clientTokens.Load(config); ok {
+		return token.(*clientToken)
+	}
+	return nil
+}
+
+// applyClientToken sets the bearer token of a request of a client config to its current token, requesting a new token first when
+// the current token expires within the refresh margin
+func applyClientToken(config *platformclientv2.Configuration, request *http.Request) {
+	token := getClientToken(config)
+	if token == nil || request == nil || !strings.HasPrefix(request.Header.Get("Authorization"), "Bearer ") {
+		return
+	}
+	if token.expiring(time.Now()) {
+		log.Println("Refreshing access token")
+		if diagErr := token.refreshIfCurrent(token.get()); diagErr != nil {
+			log.Printf("Failed to refresh access token: %v", diagErr)
+		}
+	}
+	request.Header.Set("Authorization", "Bearer "+token.get())
+}
+
+// syncClientToken sets the AccessToken of a client config to its current token. It must only be called while the client config
+// is not used by anything else, e.g. when it is acquired from a Pool.
+func syncClientToken(config *platformclientv2.Configuration) {
+	if token := getClientToken(config); token != nil {
+		if value := token.get(); config.AccessToken != value {
+			config.AccessToken = value
+		}
+	}
+}
+
+// reauthorizeUnauthorized requests a new token for a client config when the API rejects its current token, e.g. when the token was
+// revoked or expired before it was refreshed. The request itself is not retried, but the requests that follow use the new token.
+func reauthorizeUnauthorized(config *platformclientv2.Configuration, response *http.Response) {
+	token := getClientToken(config)
+	if token == nil || response.StatusCode != http.StatusUnauthorized || response.Request == nil {
+		return
+	}
+	rejected, isBearer := strings.CutPrefix(response.Request.Header.Get("Authorization"), "Bearer ")
+	if !isBearer {
+		return
+	}
+	log.Printf("Access token rejected with response %s. Requesting a new token", response.Status)
+	if diagErr := token.refreshIfCurrent(rejected); diagErr != nil {
+		log.Printf("Failed to request a new access token: %v", diagErr)
+	}
+}
+
+// authorizeClientConfig sets the access token of a client config, using a cached token when the token cache is enabled, and
+// refreshes the token when a request is sent shortly before it expires or when the API rejects it
+func authorizeClientConfig(data *schema.ResourceData, config *platformclientv2.Configuration) diag.Diagnostics {
+	credentials, err := loadCredentials(data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if credentials.AccessToken != "" {
+		log.Print("Setting access token set on configuration instance.")
+		config.AccessToken = credentials.AccessToken
+		return nil
+	}
+
+	cache := newTokenCache(data.Get("token_cache_dir").(string))
+	authBasePath := GetAuthBasePath(config.BasePath, data.Get("auth_base_path").(string))
+	cacheKey := credentials.cacheKey(authBasePath)
+	slot := cache.claimSlot(cacheKey)
+
+	token := cache.get(cacheKey, slot, time.Now())
+	if token != nil {
+		log.Printf("Using cached access token that expires at %s", token.ExpiresAt.Format(time.RFC3339))
+	} else {
+		var diagErr diag.Diagnostics
+		if token, diagErr = requestTokenWithRetries(config, authBasePath, credentials, cache, cacheKey, slot); diagErr != nil {
+			return diagErr
+		}
+	}
+	// The client config isn't used by anything else until it is authorized
+	config.AccessToken = token.AccessToken
+
+	// The credentials are loaded again on refresh, as the assertion or secret returned by a credentials file or helper may have changed
+	clientToken := &clientToken{value: token.AccessToken, expiresAt: token.ExpiresAt}
+	clientToken.refresh = func() (*cachedToken, diag.Diagnostics) {
+		credentials, err := loadCredentials(data)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		return requestTokenWithRetries(config, authBasePath, credentials, cache, credentials.cacheKey(authBasePath), slot)
+	}
+	clientTokens.Store(config, clientToken)
+	return nil
+}
+
+// requestTokenWithRetries requests a token for the client config, retrying while the auth rate limit is exceeded, and caches it
+func requestTokenWithRetries(config *platformclientv2.Configuration, authBasePath string, credentials *authCredentials, cache *tokenCache, cacheKey string, slot int) (*cachedToken, diag.Diagnostics) {
+	var token *cachedToken
+	diagErr := withRetries(context.Background(), time.Minute, func() *retry.RetryError {
+		authResponse, err := requestToken(config, authBasePath, credentials)
+		if err != nil {
+			if !strings.Contains(err.Error(), "Auth Error: 400 - invalid_request (rate limit exceeded;") {
+				return retry.NonRetryableError(fmt.Errorf("failed to authorize Genesys Cloud client credentials: %v", err))
+			}
+			return retry.RetryableError(fmt.Errorf("exhausted retries on Genesys Cloud client credentials. %v", err))
+		}
+
+		token = &cachedToken{AccessToken: authResponse.AccessToken, ExpiresAt: time.Now().Add(time.Duration(authResponse.ExpiresIn) * time.Second)}
+		return nil
+	})
+	if diagErr != nil {
+		return nil, diagErr
+	}
+
+	if err := cache.put(cacheKey, slot, token); err != nil {
+		log.Printf("Failed to cache access token: %v", err)
+	}
+	return token, nil
+}
+
+// requestToken requests an access token from the auth base path with the grant of the credentials
+func requestToken(config *platformclientv2.Configuration, authBasePath string, credentials *authCredentials) (*platformclientv2.AuthResponse, error) {
+	formParams, err := credentials.formParams()
+	if err != nil {
+		return nil, err
+	}
+	headerParams := map[string]string{
+		"Authorization": "Basic " + base64.StdEncoding.EncodeToString([]byte(credentials.OAuthClientId+":"+credentials.OAuthClientSecret)),
+	}
+
+	response, err := config.APIClient.CallAPI(authBasePath+"/oauth/token", http.MethodPost, nil, headerParams, nil, formParams, "", nil)
+	if err != nil && response == nil {
+		return nil, err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		authErrorResponse := &platformclientv2.AuthErrorResponse{}
+		if err := json.Unmarshal(response.RawBody, authErrorResponse); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("Auth Error: %v - %v (%v)", response.StatusCode, authErrorResponse.Error, authErrorResponse.ErrorDescription)
+	}
+
+	authResponse := &platformclientv2.AuthResponse{}
+	if err := json.Unmarshal(response.RawBody, authResponse); err != nil {
+		return nil, err
+	}
+	if authResponse.AccessToken == "" {
+		return nil, fmt.Errorf("Auth Error: No access token found")
+	}
+	return authResponse, nil
+}
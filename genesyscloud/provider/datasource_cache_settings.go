@@ -0,0 +1,26 @@
+package provider
+
+import (
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceCacheSettings are the settings of the on-disk cache shared by the data sources of every provider process for an org
+type DataSourceCacheSettings struct {
+	Dir   string
+	TTL   time.Duration
+	OrgId string
+}
+
+// readDataSourceCacheSettings returns the settings of the on-disk data source cache of a provider config, or nil when it is disabled
+func readDataSourceCacheSettings(data *schema.ResourceData, orgId string) *DataSourceCacheSettings {
+	dir := data.Get("datasource_cache_dir").(string)
+	if dir == "" {
+		return nil
+	}
+	ttl := time.Duration(data.Get("datasource_cache_ttl").(int)) * time.Second
+	log.Printf("Caching data source lookups for org %s in %s for %v", orgId, dir, ttl)
+	return &DataSourceCacheSettings{Dir: dir, TTL: ttl, OrgId: orgId}
+}
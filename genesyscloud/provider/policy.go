@@ -0,0 +1,324 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+The policy blocks of the provider config are rules that the attributes of resources must follow, e.g. naming conventions, a
+mandatory division_id or the bounds of the acw_timeout_ms of queues. The policies are evaluated during plan for every resource of
+one of the resource types of the policy. Every attribute of a resource that is created is evaluated, while only the attributes that
+change are evaluated for resources that are updated, so existing resources that don't follow a new policy can still be planned.
+
+Every violation fails the plan.
+*/
+
+type Policy struct {
+	Name            string
+	ResourceTypes   []string
+	Attribute       string
+	Required        bool
+	Pattern         *regexp.Regexp
+	Min             *float64
+	Max             *float64
+	AllowedValues   []string
+	ForbiddenValues []string
+	Message         string
+}
+
+func policySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Rules that the attributes of resources must follow, evaluated during plan. The conditions of a policy that are not set are not evaluated.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Name of the policy, included in the violations of the policy.",
+				},
+				"resource_types": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Resource types the policy applies to. Types can contain `*` wildcards, e.g. `genesyscloud_routing_*`. The policy applies to every resource type with the attribute when not set.",
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validateResourceTypePattern,
+					},
+				},
+				"attribute": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Attribute the policy applies to. Attributes of nested blocks are separated by dots, e.g. `members.ring_num`.",
+				},
+				"required": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "The attribute must be set in the config. Strings must not be empty.",
+				},
+				"pattern": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Regular expression that the attribute must match, e.g. `^Q_[A-Za-z]+$`.",
+					ValidateFunc: validation.StringIsValidRegExp,
+				},
+				"min": {
+					Type:        schema.TypeFloat,
+					Optional:    true,
+					Description: "Min value of the attribute.",
+				},
+				"max": {
+					Type:        schema.TypeFloat,
+					Optional:    true,
+					Description: "Max value of the attribute.",
+				},
+				"allowed_values": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Values that the attribute must be one of.",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"forbidden_values": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Values that the attribute must not be, e.g. the ID of the home division.",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"message": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Message of the violations of the policy, in place of a description of the condition that is not met.",
+				},
+			},
+		},
+	}
+}
+
+func validateResourceTypePattern(i interface{}, k string) ([]string, []error) {
+	pattern, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, []error{fmt.Errorf("%s is not a valid resource type pattern: %v", pattern, err)}
+	}
+	return nil, nil
+}
+
+func readPolicies(data *schema.ResourceData) []*Policy {
+	policyList, _ := data.Get("policy").([]interface{})
+	policies := make([]*Policy, 0, len(policyList))
+	for i, item := range policyList {
+		if item == nil {
+			continue
+		}
+		policyMap := item.(map[string]interface{})
+		policy := &Policy{
+			Name:      policyMap["name"].(string),
+			Attribute: policyMap["attribute"].(string),
+			Required:  policyMap["required"].(bool),
+			Message:   policyMap["message"].(string),
+		}
+		for _, resourceType := range policyMap["resource_types"].([]interface{}) {
+			policy.ResourceTypes = append(policy.ResourceTypes, resourceType.(string))
+		}
+		if pattern := policyMap["pattern"].(string); pattern != "" {
+			policy.Pattern = regexp.MustCompile(pattern)
+		}
+		// More info about using deprecated GetOkExists: https://github.com/hashicorp/terraform-plugin-sdk/issues/817
+		if value, ok := data.GetOkExists(fmt.Sprintf("policy.%d.min", i)); ok {
+			minValue := value.(float64)
+			policy.Min = &minValue
+		}
+		if value, ok := data.GetOkExists(fmt.Sprintf("policy.%d.max", i)); ok {
+			maxValue := value.(float64)
+			policy.Max = &maxValue
+		}
+		for _, value := range policyMap["allowed_values"].([]interface{}) {
+			policy.AllowedValues = append(policy.AllowedValues, value.(string))
+		}
+		for _, value := range policyMap["forbidden_values"].([]interface{}) {
+			policy.ForbiddenValues = append(policy.ForbiddenValues, value.(string))
+		}
+		policies = append(policies, policy)
+	}
+	return policies
+}
+
+// AppliesTo returns true when the policy applies to a resource type
+func (p *Policy) AppliesTo(resourceType string) bool {
+	if len(p.ResourceTypes) == 0 {
+		return true
+	}
+	for _, pattern := range p.ResourceTypes {
+		if matched, _ := path.Match(pattern, resourceType); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// withPolicies returns a copy of a resource that evaluates the policies of the provider during plan
+func withPolicies(resourceType string, resource *schema.Resource) *schema.Resource {
+	wrapped := *resource
+	evaluate := evaluatePolicies(resourceType, resource.Schema)
+	customizeDiff := resource.CustomizeDiff
+	wrapped.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if customizeDiff != nil {
+			if err := customizeDiff(ctx, d, meta); err != nil {
+				return err
+			}
+		}
+		return evaluate(ctx, d, meta)
+	}
+	return &wrapped
+}
+
+func evaluatePolicies(resourceType string, resourceSchema map[string]*schema.Schema) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		providerMeta, ok := meta.(*ProviderMeta)
+		if !ok || len(providerMeta.Policies) == 0 {
+			return nil
+		}
+
+		var errs []error
+		for _, policy := range providerMeta.Policies {
+			attributePath := strings.Split(policy.Attribute, ".")
+			if !policy.AppliesTo(resourceType) || resourceSchema[attributePath[0]] == nil {
+				continue
+			}
+			if d.Id() != "" && !d.HasChange(attributePath[0]) {
+				continue
+			}
+			for _, violation := range policy.violations(d, attributePath) {
+				errs = append(errs, fmt.Errorf("policy %q violated by %s: %s", policy.Name, policy.Attribute, violation))
+			}
+		}
+		return errors.Join(errs...)
+	}
+}
+
+// violations returns a description of every condition of the policy that the values of an attribute don't meet
+func (p *Policy) violations(d *schema.ResourceDiff, attributePath []string) []string {
+	var violations []string
+	add := func(format string, a ...interface{}) {
+		if p.Message != "" {
+			violations = append(violations, p.Message)
+			return
+		}
+		violations = append(violations, fmt.Sprintf(format, a...))
+	}
+
+	// Attributes that are not set in the config are known after apply when they are computed by the API
+	if p.Required && len(attributePath) == 1 && isNullInConfig(d.GetRawConfig(), attributePath[0]) {
+		add("must be set")
+		return violations
+	}
+	if !d.NewValueKnown(attributePath[0]) {
+		return violations
+	}
+
+	values := attributeValues(d.Get(attributePath[0]), attributePath[1:])
+	if len(attributePath) == 1 && len(values) == 0 {
+		// Lists and sets that are not set have no values
+		values = []interface{}{nil}
+	}
+	for _, value := range values {
+		if value == nil || value == "" {
+			if p.Required {
+				add("must be set")
+			}
+			continue
+		}
+		stringValue := policyValueString(value)
+		if stringValue == unknownVariableValue {
+			continue
+		}
+		if p.Pattern != nil && !p.Pattern.MatchString(stringValue) {
+			add("%q does not match %s", stringValue, p.Pattern.String())
+		}
+		if p.Min != nil || p.Max != nil {
+			number, err := strconv.ParseFloat(stringValue, 64)
+			if err != nil {
+				add("%q is not a number", stringValue)
+			} else if p.Min != nil && number < *p.Min {
+				add("%s is less than the min of %s", stringValue, formatPolicyNumber(*p.Min))
+			} else if p.Max != nil && number > *p.Max {
+				add("%s is greater than the max of %s", stringValue, formatPolicyNumber(*p.Max))
+			}
+		}
+		if len(p.AllowedValues) > 0 && !containsString(p.AllowedValues, stringValue) {
+			add("%q is not one of %s", stringValue, strings.Join(p.AllowedValues, ", "))
+		}
+		if containsString(p.ForbiddenValues, stringValue) {
+			add("%q is not allowed", stringValue)
+		}
+	}
+	return violations
+}
+
+// attributeValues returns the values of an attribute. Attributes of nested objects are found by following the path of the
+// attribute, e.g. members.ring_num.
+func attributeValues(value interface{}, path []string) []interface{} {
+	var values []interface{}
+	switch v := value.(type) {
+	case *schema.Set:
+		return attributeValues(v.List(), path)
+	case []interface{}:
+		for _, item := range v {
+			values = append(values, attributeValues(item, path)...)
+		}
+	case map[string]interface{}:
+		if len(path) > 0 {
+			values = append(values, attributeValues(v[path[0]], path[1:])...)
+		}
+	default:
+		if len(path) == 0 {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// policyValueString returns a value of an attribute as a string
+func policyValueString(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return formatPolicyNumber(v)
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+func formatPolicyNumber(number float64) string {
+	return strconv.FormatFloat(number, 'f', -1, 64)
+}
+
+// isNullInConfig returns true when a top level attribute is not set in the config of a resource
+func isNullInConfig(config cty.Value, attribute string) bool {
+	if config.IsNull() || !config.IsKnown() || !config.Type().IsObjectType() || !config.Type().HasAttribute(attribute) {
+		return false
+	}
+	return config.GetAttr(attribute).IsNull()
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestUnitPolicies verifies the policy blocks are read and the changed attributes of resources are evaluated during plan
+func TestUnitPolicies(t *testing.T) {
+	policies := readPolicies(schema.TestResourceDataRaw(t, providerSchema(), map[string]interface{}{
+		"policy": []interface{}{
+			map[string]interface{}{
+				"name":           "queue_names",
+				"resource_types": []interface{}{"test_routing_*"},
+				"attribute":      "name",
+				"pattern":        "^Q_",
+			},
+			map[string]interface{}{
+				"name":             "divisions",
+				"attribute":        "division_id",
+				"required":         true,
+				"forbidden_values": []interface{}{"home"},
+			},
+			map[string]interface{}{
+				"name":      "acw_timeout",
+				"attribute": "acw_timeout_ms",
+				"min":       0,
+				"max":       60000,
+			},
+			map[string]interface{}{
+				"name":      "ring_numbers",
+				"attribute": "members.ring_num",
+				"min":       1,
+				"max":       3,
+			},
+			map[string]interface{}{
+				"name":           "user_names",
+				"resource_types": []interface{}{"test_user"},
+				"attribute":      "name",
+				"allowed_values": []interface{}{"Admin"},
+				"message":        "only the admin user is managed by Terraform",
+			},
+		},
+	}))
+	if assert.Len(t, policies, 5) {
+		assert.Equal(t, "^Q_", policies[0].Pattern.String())
+		assert.Nil(t, policies[0].Min)
+		if assert.NotNil(t, policies[2].Min) && assert.NotNil(t, policies[2].Max) {
+			assert.Equal(t, 0.0, *policies[2].Min)
+			assert.Equal(t, 60000.0, *policies[2].Max)
+		}
+	}
+	assert.Empty(t, readPolicies(schema.TestResourceDataRaw(t, providerSchema(), map[string]interface{}{})))
+
+	resource := withPolicies("test_routing_queue", &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name":           {Type: schema.TypeString, Required: true},
+			"division_id":    {Type: schema.TypeString, Optional: true},
+			"acw_timeout_ms": {Type: schema.TypeInt, Optional: true},
+			"members": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+					"ring_num": {Type: schema.TypeInt, Optional: true},
+				}},
+			},
+		},
+	})
+	meta := &ProviderMeta{Policies: policies}
+	plan := func(state *terraform.InstanceState, config map[string]interface{}) error {
+		_, err := resource.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), meta)
+		return err
+	}
+
+	assert.NoError(t, plan(nil, map[string]interface{}{
+		"name":           "Q_Sales",
+		"division_id":    "sales",
+		"acw_timeout_ms": 30000,
+		"members":        []interface{}{map[string]interface{}{"ring_num": 2}},
+	}))
+
+	err := plan(nil, map[string]interface{}{
+		"name":           "Sales",
+		"acw_timeout_ms": 90000,
+		"members":        []interface{}{map[string]interface{}{"ring_num": 5}},
+	})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), `policy "queue_names" violated by name: "Sales" does not match ^Q_`)
+		assert.Contains(t, err.Error(), `policy "divisions" violated by division_id: must be set`)
+		assert.Contains(t, err.Error(), `policy "acw_timeout" violated by acw_timeout_ms: 90000 is greater than the max of 60000`)
+		assert.Contains(t, err.Error(), `policy "ring_numbers" violated by members.ring_num: 5 is greater than the max of 3`)
+		assert.NotContains(t, err.Error(), "user_names")
+	}
+
+	// Only the attributes that change are evaluated on update
+	state := &terraform.InstanceState{
+		ID: "queue-1",
+		Attributes: map[string]string{
+			"id":             "queue-1",
+			"name":           "Sales",
+			"division_id":    "sales",
+			"acw_timeout_ms": "30000",
+		},
+	}
+	assert.NoError(t, plan(state, map[string]interface{}{
+		"name":           "Sales",
+		"division_id":    "sales",
+		"acw_timeout_ms": 60000,
+	}))
+	err = plan(state, map[string]interface{}{
+		"name":           "Sales",
+		"division_id":    "home",
+		"acw_timeout_ms": 30000,
+	})
+	if assert.Error(t, err) {
+		assert.Equal(t, `policy "divisions" violated by division_id: "home" is not allowed`, err.Error())
+	}
+
+	// Policies are not evaluated when none are set
+	_, err = resource.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{"name": "Sales"}), &ProviderMeta{})
+	assert.NoError(t, err)
+
+	user := withPolicies("test_user", &schema.Resource{
+		Schema: map[string]*schema.Schema{"name": {Type: schema.TypeString, Required: true}},
+	})
+	_, err = user.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{"name": "Agent"}), meta)
+	if assert.Error(t, err) {
+		assert.Equal(t, `policy "user_names" violated by name: only the admin user is managed by Terraform`, err.Error())
+	}
+
+	config := cty.ObjectVal(map[string]cty.Value{"division_id": cty.NullVal(cty.String), "name": cty.StringVal("Sales")})
+	assert.True(t, isNullInConfig(config, "division_id"))
+	assert.False(t, isNullInConfig(config, "name"))
+	assert.False(t, isNullInConfig(cty.NullVal(config.Type()), "division_id"))
+}
@@ -50,141 +50,260 @@ func New(version string, providerResources map[string]*schema.Resource, provider
 		*/
 		copiedResources := make(map[string]*schema.Resource)
 		for k, v := range providerResources {
-			copiedResources[k] = v
+			copiedResources[k] = withPolicies(k, withReferenceValidation(k, withAPITelemetry(k, v, false)))
 		}
 
 		copiedDataSources := make(map[string]*schema.Resource)
 		for k, v := range providerDataSources {
-			copiedDataSources[k] = v
+			copiedDataSources[k] = withAPITelemetry(k, v, true)
 		}
 
 		return &schema.Provider{
-			Schema: map[string]*schema.Schema{
-				"access_token": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_ACCESS_TOKEN", nil),
-					Description: "A string that the OAuth client uses to make requests. Can be set with the `GENESYSCLOUD_ACCESS_TOKEN` environment variable.",
-				},
-				"oauthclient_id": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_OAUTHCLIENT_ID", nil),
-					Description: "OAuthClient ID found on the OAuth page of Admin UI. Can be set with the `GENESYSCLOUD_OAUTHCLIENT_ID` environment variable.",
-				},
-				"oauthclient_secret": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_OAUTHCLIENT_SECRET", nil),
-					Description: "OAuthClient secret found on the OAuth page of Admin UI. Can be set with the `GENESYSCLOUD_OAUTHCLIENT_SECRET` environment variable.",
-					Sensitive:   true,
-				},
-				"aws_region": {
-					Type:         schema.TypeString,
-					Optional:     true,
-					DefaultFunc:  schema.EnvDefaultFunc("GENESYSCLOUD_REGION", nil),
-					Description:  "AWS region where org exists. e.g. us-east-1. Can be set with the `GENESYSCLOUD_REGION` environment variable.",
-					ValidateFunc: validation.StringInSlice(getAllowedRegions(), true),
-				},
-				"sdk_debug": {
-					Type:        schema.TypeBool,
-					Optional:    true,
-					DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_SDK_DEBUG", false),
-					Description: "Enables debug tracing in the Genesys Cloud SDK. Output will be written to the local file 'sdk_debug.log'. Can be set with the `GENESYSCLOUD_SDK_DEBUG` environment variable.",
-				},
-				"sdk_debug_format": {
-					Type:         schema.TypeString,
-					Optional:     true,
-					DefaultFunc:  schema.EnvDefaultFunc("GENESYSCLOUD_SDK_DEBUG_FORMAT", "Text"),
-					Description:  "Specifies the data format of the 'sdk_debug.log'. Only applicable if sdk_debug is true. Can be set with the `GENESYSCLOUD_SDK_DEBUG_FORMAT` environment variable. Default value is Text.",
-					ValidateFunc: validation.StringInSlice([]string{"Text", "Json"}, false),
-				},
-				"sdk_debug_file_path": {
-					Type:         schema.TypeString,
-					Optional:     true,
-					DefaultFunc:  schema.EnvDefaultFunc("GENESYSCLOUD_SDK_DEBUG_FILE_PATH", "sdk_debug.log"),
-					Description:  "Specifies the file path for the log file. Can be set with the `GENESYSCLOUD_SDK_DEBUG_FILE_PATH` environment variable. Default value is sdk_debug.log",
-					ValidateFunc: validation.StringDoesNotMatch(regexp.MustCompile("^(|\\s+)$"), "Invalid File path "),
-				},
-				"token_pool_size": {
-					Type:         schema.TypeInt,
-					Optional:     true,
-					DefaultFunc:  schema.EnvDefaultFunc("GENESYSCLOUD_TOKEN_POOL_SIZE", 10),
-					Description:  "Max number of OAuth tokens in the token pool. Can be set with the `GENESYSCLOUD_TOKEN_POOL_SIZE` environment variable.",
-					ValidateFunc: validation.IntBetween(1, 20),
-				},
-				"proxy": {
-					Type:     schema.TypeSet,
-					Optional: true,
-					MaxItems: 1,
-					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							"port": {
-								Type:        schema.TypeString,
-								Optional:    true,
-								DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_PROXY_PORT", nil),
-								Description: "Port for the proxy can be set with the `GENESYSCLOUD_PROXY_PORT` environment variable.",
-							},
-							"host": {
-								Type:        schema.TypeString,
-								Optional:    true,
-								DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_PROXY_HOST", nil),
-								Description: "Host for the proxy can be set with the `GENESYSCLOUD_PROXY_HOST` environment variable.",
-							},
-							"protocol": {
-								Type:        schema.TypeString,
-								Optional:    true,
-								DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_PROXY_PROTOCOL", nil),
-								Description: "Protocol for the proxy can be set with the `GENESYSCLOUD_PROXY_PROTOCOL` environment variable.",
-							},
+			Schema:               providerSchema(),
+			ResourcesMap:         copiedResources,
+			DataSourcesMap:       copiedDataSources,
+			ConfigureContextFunc: configure(version),
+		}
+	}
+}
 
-							"auth": {
-								Type:     schema.TypeSet,
-								Optional: true,
-								MaxItems: 1,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										"username": {
-											Type:        schema.TypeString,
-											Optional:    true,
-											DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_PROXY_AUTH_USERNAME", nil),
-											Description: "UserName for the Auth can be set with the `GENESYSCLOUD_PROXY_AUTH_USERNAME` environment variable.",
-										},
-										"password": {
-											Type:        schema.TypeString,
-											Optional:    true,
-											DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_PROXY_AUTH_PASSWORD", nil),
-											Description: "Password for the Auth can be set with the `GENESYSCLOUD_PROXY_AUTH_PASSWORD` environment variable.",
-										},
-									},
+// providerSchema returns the schema of the provider configuration
+func providerSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"access_token": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_ACCESS_TOKEN", nil),
+			Description: "A string that the OAuth client uses to make requests. Can be set with the `GENESYSCLOUD_ACCESS_TOKEN` environment variable.",
+		},
+		"oauthclient_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_OAUTHCLIENT_ID", nil),
+			Description: "OAuthClient ID found on the OAuth page of Admin UI. Can be set with the `GENESYSCLOUD_OAUTHCLIENT_ID` environment variable.",
+		},
+		"oauthclient_secret": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_OAUTHCLIENT_SECRET", nil),
+			Description: "OAuthClient secret found on the OAuth page of Admin UI. Can be set with the `GENESYSCLOUD_OAUTHCLIENT_SECRET` environment variable.",
+			Sensitive:   true,
+		},
+		"saml2_assertion": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			DefaultFunc:   schema.EnvDefaultFunc("GENESYSCLOUD_SAML2_ASSERTION", nil),
+			Description:   "Base64 encoded SAML2 assertion used to authorize the OAuth client with the SAML2 bearer grant. Requires `org_name`. Can be set with the `GENESYSCLOUD_SAML2_ASSERTION` environment variable.",
+			Sensitive:     true,
+			ConflictsWith: []string{"jwt_assertion"},
+		},
+		"org_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_ORG_NAME", nil),
+			Description: "Short name of the org, required by the SAML2 bearer grant. Can be set with the `GENESYSCLOUD_ORG_NAME` environment variable.",
+		},
+		"jwt_assertion": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			DefaultFunc:   schema.EnvDefaultFunc("GENESYSCLOUD_JWT_ASSERTION", nil),
+			Description:   "Signed JWT used to authorize the OAuth client with the JWT bearer grant. Can be set with the `GENESYSCLOUD_JWT_ASSERTION` environment variable.",
+			Sensitive:     true,
+			ConflictsWith: []string{"saml2_assertion"},
+		},
+		"credentials_file": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			DefaultFunc:   schema.EnvDefaultFunc("GENESYSCLOUD_CREDENTIALS_FILE", nil),
+			Description:   "Path to a JSON file with the credentials of the provider. The file can contain the `access_token`, `oauthclient_id`, `oauthclient_secret`, `saml2_assertion`, `org_name` and `jwt_assertion` fields, which are used when the attribute is not set in the provider config. The file is read again when a token is refreshed. Can be set with the `GENESYSCLOUD_CREDENTIALS_FILE` environment variable.",
+			ConflictsWith: []string{"credentials_helper"},
+		},
+		"credentials_helper": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			DefaultFunc:   schema.EnvDefaultFunc("GENESYSCLOUD_CREDENTIALS_HELPER", nil),
+			Description:   "Command that writes the credentials of the provider to stdout in the JSON format of `credentials_file`. The command is run with `sh -c`, or `cmd /C` on Windows, and run again when a token is refreshed. Can be set with the `GENESYSCLOUD_CREDENTIALS_HELPER` environment variable.",
+			ConflictsWith: []string{"credentials_file"},
+		},
+		"token_cache_dir": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_TOKEN_CACHE_DIR", nil),
+			Description: "Directory to cache access tokens in between provider runs, so that parallel runs reuse tokens rather than each requesting new ones. Tokens are cached per region, OAuth client, grant and org, and are only readable by the current user. Can be set with the `GENESYSCLOUD_TOKEN_CACHE_DIR` environment variable.",
+		},
+		"aws_region": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			DefaultFunc:  schema.EnvDefaultFunc("GENESYSCLOUD_REGION", nil),
+			Description:  "AWS region where org exists. e.g. us-east-1. Can be set with the `GENESYSCLOUD_REGION` environment variable.",
+			ValidateFunc: validation.StringInSlice(getAllowedRegions(), true),
+		},
+		"base_path": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			DefaultFunc:  schema.EnvDefaultFunc("GENESYSCLOUD_BASE_PATH", nil),
+			Description:  "Base URL of the Genesys Cloud API, e.g. an API gateway, a private link endpoint or a local mock server. Overrides the API host of `aws_region`. Can be set with the `GENESYSCLOUD_BASE_PATH` environment variable.",
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+		},
+		"auth_base_path": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			DefaultFunc:  schema.EnvDefaultFunc("GENESYSCLOUD_AUTH_BASE_PATH", nil),
+			Description:  "Base URL that OAuth tokens are requested from. Defaults to the login host of `base_path` when it starts with `api.`, and to `base_path` itself otherwise. Can be set with the `GENESYSCLOUD_AUTH_BASE_PATH` environment variable.",
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			RequiredWith: []string{"base_path"},
+		},
+		"sdk_debug": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_SDK_DEBUG", false),
+			Description: "Enables debug tracing in the Genesys Cloud SDK. Output will be written to the local file 'sdk_debug.log'. Can be set with the `GENESYSCLOUD_SDK_DEBUG` environment variable.",
+		},
+		"sdk_debug_format": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			DefaultFunc:  schema.EnvDefaultFunc("GENESYSCLOUD_SDK_DEBUG_FORMAT", "Text"),
+			Description:  "Specifies the data format of the 'sdk_debug.log'. Only applicable if sdk_debug is true. Can be set with the `GENESYSCLOUD_SDK_DEBUG_FORMAT` environment variable. Default value is Text.",
+			ValidateFunc: validation.StringInSlice([]string{"Text", "Json"}, false),
+		},
+		"sdk_debug_file_path": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			DefaultFunc:  schema.EnvDefaultFunc("GENESYSCLOUD_SDK_DEBUG_FILE_PATH", "sdk_debug.log"),
+			Description:  "Specifies the file path for the log file. Can be set with the `GENESYSCLOUD_SDK_DEBUG_FILE_PATH` environment variable. Default value is sdk_debug.log",
+			ValidateFunc: validation.StringDoesNotMatch(regexp.MustCompile("^(|\\s+)$"), "Invalid File path "),
+		},
+		"token_pool_size": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			DefaultFunc:  schema.EnvDefaultFunc("GENESYSCLOUD_TOKEN_POOL_SIZE", 10),
+			Description:  "Max number of OAuth tokens in the token pool. Can be set with the `GENESYSCLOUD_TOKEN_POOL_SIZE` environment variable.",
+			ValidateFunc: validation.IntBetween(1, 20),
+		},
+		"token_pool_acquire_timeout": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			DefaultFunc:  schema.EnvDefaultFunc("GENESYSCLOUD_TOKEN_POOL_ACQUIRE_TIMEOUT", 0),
+			Description:  "Max number of seconds a resource operation waits for an OAuth token from the token pool. 0 waits until the timeout of the operation. Can be set with the `GENESYSCLOUD_TOKEN_POOL_ACQUIRE_TIMEOUT` environment variable.",
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		"token_pool_adaptive": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_TOKEN_POOL_ADAPTIVE", false),
+			Description: "Halve the number of OAuth tokens of the token pool used at once when the API rate limits a request, and grow it back while requests succeed. Defaults to `false`, which uses every token of the pool at once. Can be set with the `GENESYSCLOUD_TOKEN_POOL_ADAPTIVE` environment variable.",
+		},
+		"api_telemetry_file": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_API_TELEMETRY_FILE", nil),
+			Description: "Path of a report of the API requests made by every resource type and operation: the number of requests, bytes sent and received, status codes and latency. The report is written as JSON, or as CSV when the path ends with `.csv`, while the provider runs and when it stops. Can be set with the `GENESYSCLOUD_API_TELEMETRY_FILE` environment variable.",
+		},
+		"datasource_cache_dir": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_DATASOURCE_CACHE_DIR", nil),
+			Description: "Directory of an on-disk cache of the IDs that data sources look up by name, shared by every provider process for the same org. The cache is disabled when not set. Can be set with the `GENESYSCLOUD_DATASOURCE_CACHE_DIR` environment variable.",
+		},
+		"datasource_cache_ttl": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			DefaultFunc:  schema.EnvDefaultFunc("GENESYSCLOUD_DATASOURCE_CACHE_TTL", 3600),
+			Description:  "Number of seconds an entry of the on-disk data source cache is used before it is revalidated with the API. Can be set with the `GENESYSCLOUD_DATASOURCE_CACHE_TTL` environment variable.",
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		"read_only": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_READ_ONLY", false),
+			Description: "Fail every create, update and delete of a resource with an error describing the intended change instead of sending it to the API, so that plans and reads can be run against an org without changing it. Can be set with the `GENESYSCLOUD_READ_ONLY` environment variable.",
+		},
+		"validate_references": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_VALIDATE_REFERENCES", false),
+			Description: "Verify during plan that the objects referenced by changed attributes, e.g. `queue_flow_id` or `division_id`, exist in the org and are of the expected type. Can be set with the `GENESYSCLOUD_VALIDATE_REFERENCES` environment variable.",
+		},
+		"retry":  retryPolicySchema(),
+		"policy": policySchema(),
+		"proxy": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"port": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_PROXY_PORT", nil),
+						Description: "Port for the proxy can be set with the `GENESYSCLOUD_PROXY_PORT` environment variable.",
+					},
+					"host": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_PROXY_HOST", nil),
+						Description: "Host for the proxy can be set with the `GENESYSCLOUD_PROXY_HOST` environment variable.",
+					},
+					"protocol": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_PROXY_PROTOCOL", nil),
+						Description: "Protocol for the proxy can be set with the `GENESYSCLOUD_PROXY_PROTOCOL` environment variable.",
+					},
+
+					"auth": {
+						Type:     schema.TypeSet,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"username": {
+									Type:        schema.TypeString,
+									Optional:    true,
+									DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_PROXY_AUTH_USERNAME", nil),
+									Description: "UserName for the Auth can be set with the `GENESYSCLOUD_PROXY_AUTH_USERNAME` environment variable.",
+								},
+								"password": {
+									Type:        schema.TypeString,
+									Optional:    true,
+									DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_PROXY_AUTH_PASSWORD", nil),
+									Description: "Password for the Auth can be set with the `GENESYSCLOUD_PROXY_AUTH_PASSWORD` environment variable.",
 								},
 							},
 						},
 					},
 				},
 			},
-			ResourcesMap:         copiedResources,
-			DataSourcesMap:       copiedDataSources,
-			ConfigureContextFunc: configure(version),
-		}
+		},
 	}
 }
 
 type ProviderMeta struct {
-	Version      string
-	ClientConfig *platformclientv2.Configuration
-	Domain       string
-	Organization *platformclientv2.Organization
+	Version            string
+	ClientConfig       *platformclientv2.Configuration
+	ClientPool         *SDKClientPool
+	Domain             string
+	Organization       *platformclientv2.Organization
+	ValidateReferences bool
+	ReadOnly           bool
+	Policies           []*Policy
+	DataSourceCache    *DataSourceCacheSettings
+	RetryPolicy        *RetryPolicy
+
+	// rateLimiter limits the requests of the pooled clients acquired with the meta, see WithRequestLimit
+	rateLimiter *apiRateLimiter
 }
 
 func configure(version string) schema.ConfigureContextFunc {
 	return func(context context.Context, data *schema.ResourceData) (interface{}, diag.Diagnostics) {
-		err := InitSDKClientPool(data.Get("token_pool_size").(int), version, data)
+		enableAPITelemetry(data.Get("api_telemetry_file").(string))
+
+		pool, err := InitSDKClientPool(data.Get("token_pool_size").(int), version, data)
 		if err != nil {
 			return nil, err
 		}
 
-		defaultConfig := platformclientv2.GetDefaultConfiguration()
+		defaultConfig := pool.DefaultConfig
 
 		currentOrg, err := getOrganizationMe(defaultConfig)
 		if err != nil {
@@ -193,10 +312,16 @@ func configure(version string) schema.ConfigureContextFunc {
 		orgDefaultCountryCode = *currentOrg.DefaultCountryCode
 
 		return &ProviderMeta{
-			Version:      version,
-			ClientConfig: defaultConfig,
-			Domain:       getRegionDomain(data.Get("aws_region").(string)),
-			Organization: currentOrg,
+			Version:            version,
+			ClientConfig:       defaultConfig,
+			ClientPool:         pool,
+			Domain:             getRegionDomain(data.Get("aws_region").(string)),
+			Organization:       currentOrg,
+			ValidateReferences: data.Get("validate_references").(bool),
+			ReadOnly:           data.Get("read_only").(bool),
+			Policies:           readPolicies(data),
+			DataSourceCache:    readDataSourceCacheSettings(data, *currentOrg.Id),
+			RetryPolicy:        readRetryPolicy(data),
 		}, nil
 	}
 }
@@ -249,12 +374,25 @@ func GetRegionBasePath(region string) string {
 	return "https://api." + getRegionDomain(region)
 }
 
+// GetBasePath returns the base path of the API. A base path, e.g. of an API gateway or a mock server, overrides the API host of the region.
+func GetBasePath(region string, basePath string) string {
+	if basePath != "" {
+		return strings.TrimSuffix(basePath, "/")
+	}
+	return GetRegionBasePath(region)
+}
+
+// GetAuthBasePath returns the base path that tokens are requested from. Without an auth base path, tokens are requested from the
+// login host of the API, or from the API itself when it isn't hosted on an api. domain, e.g. a mock server.
+func GetAuthBasePath(basePath string, authBasePath string) string {
+	if authBasePath != "" {
+		return strings.TrimSuffix(authBasePath, "/")
+	}
+	return authHostRegex.ReplaceAllString(basePath, "//login.")
+}
+
 func InitClientConfig(data *schema.ResourceData, version string, config *platformclientv2.Configuration) diag.Diagnostics {
-	accessToken := data.Get("access_token").(string)
-	oauthclientID := data.Get("oauthclient_id").(string)
-	oauthclientSecret := data.Get("oauthclient_secret").(string)
-	basePath := GetRegionBasePath(data.Get("aws_region").(string))
-	config.BasePath = basePath
+	config.BasePath = GetBasePath(data.Get("aws_region").(string), data.Get("base_path").(string))
 
 	diagErr := setUpSDKLogging(data, config)
 	if diagErr != nil {
@@ -263,39 +401,38 @@ func InitClientConfig(data *schema.ResourceData, version string, config *platfor
 	setupProxy(data, config)
 
 	config.AddDefaultHeader("User-Agent", "GC Terraform Provider/"+version)
+	retryPolicy := readRetryPolicy(data)
 	config.RetryConfiguration = &platformclientv2.RetryConfiguration{
-		RetryWaitMin: time.Second * 1,
-		RetryWaitMax: time.Second * 30,
-		RetryMax:     20,
+		RetryWaitMin: retryPolicy.sdkRetryWaitMin(),
+		RetryWaitMax: retryPolicy.sdkRetryWaitMax(),
+		RetryMax:     retryPolicy.Attempts(defaultSdkRetryMax+1) - 1,
 		RequestLogHook: func(request *http.Request, count int) {
 			if count > 0 && request != nil {
 				log.Printf("Retry #%d for %s %s", count, request.Method, request.URL)
 			}
+			countRequest(count)
+			applyClientToken(config, request)
+			if l := clientRateLimiter(config); l != nil && request != nil {
+				l.wait(request.Context())
+			}
+			telemetry.requestStarted(config, request)
 		},
 		ResponseLogHook: func(response *http.Response) {
 			if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
 				log.Printf("Response %s for request:%s %s", response.Status, response.Request.Method, response.Request.URL)
 			}
+			countResponse(response)
+			observeResponse(config, response)
+			reauthorizeUnauthorized(config, response)
+			telemetry.responseReceived(response)
+			if l := clientRateLimiter(config); l != nil {
+				l.backoff(response, time.Now())
+			}
 		},
 	}
 
-	if accessToken != "" {
-		log.Print("Setting access token set on configuration instance.")
-		config.AccessToken = accessToken
-	} else {
-		config.AutomaticTokenRefresh = true // Enable automatic token refreshing
-
-		return withRetries(context.Background(), time.Minute, func() *retry.RetryError {
-			err := config.AuthorizeClientCredentials(oauthclientID, oauthclientSecret)
-			if err != nil {
-				if !strings.Contains(err.Error(), "Auth Error: 400 - invalid_request (rate limit exceeded;") {
-					return retry.NonRetryableError(fmt.Errorf("failed to authorize Genesys Cloud client credentials: %v", err))
-				}
-				return retry.RetryableError(fmt.Errorf("exhausted retries on Genesys Cloud client credentials. %v", err))
-			}
-
-			return nil
-		})
+	if diagErr := authorizeClientConfig(data, config); diagErr != nil {
+		return diagErr
 	}
 
 	log.Printf("Initialized Go SDK Client. Debug=%t", data.Get("sdk_debug").(bool))
@@ -369,8 +506,8 @@ func setupProxy(data *schema.ResourceData, config *platformclientv2.Configuratio
 }
 
 func AuthorizeSdk() (*platformclientv2.Configuration, error) {
-	// Create new config
-	sdkConfig := platformclientv2.GetDefaultConfiguration()
+	// Create new config. The default config of the SDK is the default config of the first provider instance, so it isn't reused.
+	sdkConfig := platformclientv2.NewConfiguration()
 
 	v, exists := os.LookupEnv("TF_UNIT")
 	if exists && v != "" {
@@ -378,10 +515,15 @@ func AuthorizeSdk() (*platformclientv2.Configuration, error) {
 		return sdkConfig, nil
 	}
 
-	sdkConfig.BasePath = GetRegionBasePath(os.Getenv("GENESYSCLOUD_REGION"))
+	sdkConfig.BasePath = GetBasePath(os.Getenv("GENESYSCLOUD_REGION"), os.Getenv("GENESYSCLOUD_BASE_PATH"))
+	authBasePath := GetAuthBasePath(sdkConfig.BasePath, os.Getenv("GENESYSCLOUD_AUTH_BASE_PATH"))
+	credentials := &authCredentials{
+		OAuthClientId:     os.Getenv("GENESYSCLOUD_OAUTHCLIENT_ID"),
+		OAuthClientSecret: os.Getenv("GENESYSCLOUD_OAUTHCLIENT_SECRET"),
+	}
 
 	diagErr := withRetries(context.Background(), time.Minute, func() *retry.RetryError {
-		err := sdkConfig.AuthorizeClientCredentials(os.Getenv("GENESYSCLOUD_OAUTHCLIENT_ID"), os.Getenv("GENESYSCLOUD_OAUTHCLIENT_SECRET"))
+		token, err := requestToken(sdkConfig, authBasePath, credentials)
 		if err != nil {
 			if !strings.Contains(err.Error(), "Auth Error: 400 - invalid_request (rate limit exceeded;") {
 				return retry.NonRetryableError(fmt.Errorf("failed to authorize Genesys Cloud client credentials: %v", err))
@@ -389,6 +531,8 @@ func AuthorizeSdk() (*platformclientv2.Configuration, error) {
 			return retry.RetryableError(fmt.Errorf("exhausted retries on Genesys Cloud client credentials. %v", err))
 		}
 
+		sdkConfig.AccessToken = token.AccessToken
+		sdkConfig.AccessTokenExpiresIn = token.ExpiresIn
 		return nil
 	})
 	if diagErr != nil {
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestProvider(t *testing.T) {
@@ -11,3 +12,13 @@ func TestProvider(t *testing.T) {
 		t.Fatalf("err: %s", err)
 	}
 }
+
+// TestUnitBasePaths verifies a base path overrides the region and tokens are requested from the matching auth host
+func TestUnitBasePaths(t *testing.T) {
+	assert.Equal(t, "https://api.mypurecloud.ie", GetBasePath("eu-west-1", ""))
+	assert.Equal(t, "https://api.gateway.example.com", GetBasePath("eu-west-1", "https://api.gateway.example.com/"))
+
+	assert.Equal(t, "https://login.mypurecloud.ie", GetAuthBasePath("https://api.mypurecloud.ie", ""))
+	assert.Equal(t, "http://127.0.0.1:8089", GetAuthBasePath("http://127.0.0.1:8089", ""))
+	assert.Equal(t, "https://auth.example.com", GetAuthBasePath("https://gateway.example.com", "https://auth.example.com/"))
+}
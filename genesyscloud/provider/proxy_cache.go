@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"reflect"
+	"sync"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
+)
+
+// proxyCache holds a single proxy of every type for the client configs of a provider instance, so resources and
+// data sources configured for different orgs never share a proxy
+type proxyCache struct {
+	mutex   sync.Mutex
+	proxies map[reflect.Type]interface{}
+}
+
+// clientConfigProxies maps every client config to the proxy cache of the provider instance that owns it
+var clientConfigProxies sync.Map
+
+func (c *proxyCache) register(clientConfig *platformclientv2.Configuration) {
+	clientConfigProxies.Store(clientConfig, c)
+}
+
+func unregisterProxies(clientConfig *platformclientv2.Configuration) {
+	clientConfigProxies.Delete(clientConfig)
+}
+
+// GetProxy returns the proxy of type T for the provider instance that owns the client config, creating it with newProxy
+// on first use. Client configs that don't belong to a provider instance, e.g. the client configs of tests, get a proxy cache
+// of their own, so their proxies and the caches held by them are still reused.
+func GetProxy[T any](clientConfig *platformclientv2.Configuration, newProxy func(*platformclientv2.Configuration) T) T {
+	value, ok := clientConfigProxies.Load(clientConfig)
+	if !ok {
+		value, _ = clientConfigProxies.LoadOrStore(clientConfig, &proxyCache{})
+	}
+	cache := value.(*proxyCache)
+
+	proxyType := reflect.TypeOf((*T)(nil)).Elem()
+	if proxy, ok := cache.load(proxyType); ok {
+		return proxy.(T)
+	}
+
+	// The proxy is created without holding the lock, as proxies can get the proxies of other packages when they are created
+	return cache.loadOrStore(proxyType, newProxy(clientConfig)).(T)
+}
+
+func (c *proxyCache) load(proxyType reflect.Type) (interface{}, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	proxy, ok := c.proxies[proxyType]
+	return proxy, ok
+}
+
+func (c *proxyCache) loadOrStore(proxyType reflect.Type, proxy interface{}) interface{} {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if existing, ok := c.proxies[proxyType]; ok {
+		return existing
+	}
+	if c.proxies == nil {
+		c.proxies = make(map[reflect.Type]interface{})
+	}
+	c.proxies[proxyType] = proxy
+	return proxy
+}
@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
+)
+
+// apiRateLimiter throttles the requests of an export. Requests can be spaced out to stay within a requests per second budget,
+// and when the API responds with a Retry-After header every client of the export waits until the given time rather than only
+// the client that received the response. Only the clients acquired with the meta or context of the export are limited, so the
+// other operations of the provider are neither throttled nor paused.
+type apiRateLimiter struct {
+	mutex       sync.Mutex
+	interval    time.Duration
+	next        time.Time
+	pausedUntil time.Time
+}
+
+type rateLimiterContextKey struct{}
+
+// clientRateLimiters maps the client configs acquired by an export to the rate limiter of the export while they are in use
+var clientRateLimiters sync.Map
+
+func newAPIRateLimiter(requestsPerSecond int) *apiRateLimiter {
+	l := &apiRateLimiter{}
+	if requestsPerSecond > 0 {
+		l.interval = time.Second / time.Duration(requestsPerSecond)
+	}
+	return l
+}
+
+// WithRequestLimit returns a copy of the provider meta whose pooled clients send at most the given number of requests per second,
+// zero for no limit, and are paused by Retry-After headers. Contexts created by WithClientPool with the returned meta carry the
+// same limit.
+func WithRequestLimit(meta interface{}, requestsPerSecond int) interface{} {
+	providerMeta, ok := meta.(*ProviderMeta)
+	if !ok {
+		return meta
+	}
+	limited := *providerMeta
+	limited.rateLimiter = newAPIRateLimiter(requestsPerSecond)
+	return &limited
+}
+
+func rateLimiterFromContext(ctx context.Context) *apiRateLimiter {
+	l, _ := ctx.Value(rateLimiterContextKey{}).(*apiRateLimiter)
+	return l
+}
+
+// limitClient applies a rate limiter to the requests of a client config until unlimitClient is called
+func limitClient(clientConfig *platformclientv2.Configuration, l *apiRateLimiter) {
+	if l != nil {
+		clientRateLimiters.Store(clientConfig, l)
+	}
+}
+
+func unlimitClient(clientConfig *platformclientv2.Configuration) {
+	clientRateLimiters.Delete(clientConfig)
+}
+
+// clientRateLimiter returns the rate limiter applied to a client config, or nil when its requests are not limited
+func clientRateLimiter(clientConfig *platformclientv2.Configuration) *apiRateLimiter {
+	if l, ok := clientRateLimiters.Load(clientConfig); ok {
+		return l.(*apiRateLimiter)
+	}
+	return nil
+}
+
+// reserve returns how long a request made at the given time must wait before it is sent
+func (l *apiRateLimiter) reserve(now time.Time) time.Duration {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	start := now
+	if l.pausedUntil.After(start) {
+		start = l.pausedUntil
+	}
+	if l.interval > 0 {
+		if l.next.After(start) {
+			start = l.next
+		}
+		l.next = start.Add(l.interval)
+	}
+	return start.Sub(now)
+}
+
+// wait blocks until a request can be sent or the context is cancelled
+func (l *apiRateLimiter) wait(ctx context.Context) {
+	delay := l.reserve(time.Now())
+	if delay <= 0 {
+		return
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+	case <-timer.C:
+	}
+}
+
+// backoff pauses the requests of the export until the time given by the Retry-After header of a rate limited response
+func (l *apiRateLimiter) backoff(response *http.Response, now time.Time) {
+	if response.StatusCode != http.StatusTooManyRequests && response.StatusCode != http.StatusServiceUnavailable {
+		return
+	}
+	retryAfter := retryAfterDuration(response.Header.Get("Retry-After"), now)
+	if retryAfter <= 0 {
+		return
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if pausedUntil := now.Add(retryAfter); pausedUntil.After(l.pausedUntil) {
+		log.Printf("Pausing requests for %v after response %s", retryAfter, response.Status)
+		l.pausedUntil = pausedUntil
+	}
+}
+
+// retryAfterDuration parses a Retry-After header given in seconds or as an HTTP date
+func retryAfterDuration(retryAfter string, now time.Time) time.Duration {
+	if retryAfter == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(retryAfter); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(retryAfter); err == nil {
+		return date.Sub(now)
+	}
+	return 0
+}
@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
+)
+
+// TestUnitRateLimiterReserve verifies requests are spaced by the requests per second budget and paused by Retry-After headers
+func TestUnitRateLimiterReserve(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := &apiRateLimiter{interval: 100 * time.Millisecond}
+
+	for i, expected := range []time.Duration{0, 100 * time.Millisecond, 200 * time.Millisecond} {
+		if delay := l.reserve(now); delay != expected {
+			t.Errorf("request %d: expected a delay of %v, got %v", i, expected, delay)
+		}
+	}
+
+	response := &http.Response{StatusCode: http.StatusTooManyRequests, Status: "429 Too Many Requests", Header: http.Header{}}
+	response.Header.Set("Retry-After", "5")
+	l.backoff(response, now)
+	if delay := l.reserve(now); delay != 5*time.Second {
+		t.Errorf("expected requests to be paused for 5s, got %v", delay)
+	}
+
+	// A shorter Retry-After does not shorten the pause
+	response.Header.Set("Retry-After", now.Add(2*time.Second).Format(http.TimeFormat))
+	l.backoff(response, now)
+	if delay := l.reserve(now); delay != 5*time.Second+100*time.Millisecond {
+		t.Errorf("expected requests to stay paused, got a delay of %v", delay)
+	}
+
+	response.StatusCode = http.StatusNotFound
+	response.Header.Set("Retry-After", "60")
+	l.backoff(response, now)
+	if l.pausedUntil != now.Add(5*time.Second) {
+		t.Errorf("expected only rate limited responses to pause requests")
+	}
+}
+
+// TestUnitRateLimiterScope verifies only the clients acquired with the meta or context of an export are rate limited
+func TestUnitRateLimiterScope(t *testing.T) {
+	meta := &ProviderMeta{}
+	limited := WithRequestLimit(meta, 10).(*ProviderMeta)
+	if meta.rateLimiter != nil || limited.rateLimiter == nil || limited.rateLimiter.interval != 100*time.Millisecond {
+		t.Fatalf("expected only the copy of the meta to be limited to 10 requests per second")
+	}
+	if rateLimiterFromContext(WithClientPool(context.Background(), limited)) != limited.rateLimiter {
+		t.Errorf("expected the context of the limited meta to carry its rate limiter")
+	}
+	if rateLimiterFromContext(WithClientPool(context.Background(), meta)) != nil {
+		t.Errorf("expected the context of the meta not to carry a rate limiter")
+	}
+
+	clientConfig := platformclientv2.NewConfiguration()
+	limitClient(clientConfig, nil)
+	if clientRateLimiter(clientConfig) != nil {
+		t.Errorf("expected clients acquired without a rate limiter not to be limited")
+	}
+	limitClient(clientConfig, limited.rateLimiter)
+	if clientRateLimiter(clientConfig) != limited.rateLimiter {
+		t.Errorf("expected the client to be limited while it is in use by the export")
+	}
+	unlimitClient(clientConfig)
+	if clientRateLimiter(clientConfig) != nil {
+		t.Errorf("expected the client not to be limited once released")
+	}
+}
@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// withReadOnlyGuard returns an error diagnostic describing the intended call instead of running a method that changes the org
+// when the read_only provider setting is enabled
+func withReadOnlyGuard(operation string, method resContextFunc) resContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if providerMeta, ok := meta.(*ProviderMeta); !ok || !providerMeta.ReadOnly {
+			return method(ctx, d, meta)
+		}
+		summary, detail := describeIntendedCall(ctx, operation, d)
+		log.Printf("Read-only mode: %s", detail)
+		return diag.Diagnostics{{Severity: diag.Error, Summary: summary, Detail: detail}}
+	}
+}
+
+// describeIntendedCall describes the operation that a resource would have run. The resource type is taken from the label that New
+// adds to the context. Only the names of attributes are listed, as values may be sensitive.
+func describeIntendedCall(ctx context.Context, operation string, d *schema.ResourceData) (string, string) {
+	resourceType := apiTelemetryLabelFromContext(ctx).ResourceType
+	if resourceType == unattributedLabel.ResourceType {
+		resourceType = "resource"
+	}
+	summary := fmt.Sprintf("Read-only mode: %s %s was not sent to the API", resourceType, operation)
+
+	target := resourceType
+	if d.Id() != "" {
+		target += " " + d.Id()
+	}
+	detail := fmt.Sprintf("The read_only provider setting is enabled, so the %s of %s was not sent to the API.", operation, target)
+
+	if operation == OperationDelete {
+		return summary, detail
+	}
+	var attributes []string
+	if configType := d.GetRawConfig().Type(); configType.IsObjectType() {
+		for name := range configType.AttributeTypes() {
+			if name == "id" {
+				continue
+			}
+			if _, set := d.GetOk(name); (operation == OperationCreate && set) || (operation == OperationUpdate && d.HasChange(name)) {
+				attributes = append(attributes, name)
+			}
+		}
+	}
+	if len(attributes) > 0 {
+		sort.Strings(attributes)
+		label := "Attributes"
+		if operation == OperationUpdate {
+			label = "Changed attributes"
+		}
+		detail += fmt.Sprintf(" %s: %s.", label, strings.Join(attributes, ", "))
+	}
+	return summary, detail
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestUnitReadOnlyMode verifies creates, updates and deletes are not run in read-only mode and describe the intended call instead
+func TestUnitReadOnlyMode(t *testing.T) {
+	calls := 0
+	method := func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		calls++
+		return nil
+	}
+	resourceSchema := map[string]*schema.Schema{
+		"name":     {Type: schema.TypeString, Optional: true},
+		"password": {Type: schema.TypeString, Optional: true, Sensitive: true},
+	}
+	resource := withAPITelemetry("genesyscloud_test", &schema.Resource{
+		Schema:        resourceSchema,
+		CreateContext: CreateWithPooledClient(method),
+		UpdateContext: UpdateWithPooledClient(method),
+		DeleteContext: DeleteWithPooledClient(method),
+	}, false)
+	meta := &ProviderMeta{ReadOnly: true}
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{"name": "test", "password": "secret"})
+
+	diagErr := resource.CreateContext(context.Background(), d, meta)
+	if assert.True(t, diagErr.HasError()) {
+		assert.Equal(t, "Read-only mode: genesyscloud_test create was not sent to the API", diagErr[0].Summary)
+		assert.Contains(t, diagErr[0].Detail, "Attributes: name, password.")
+		assert.NotContains(t, diagErr[0].Detail, "secret")
+	}
+
+	d.SetId("1234")
+	diagErr = resource.UpdateContext(context.Background(), d, meta)
+	if assert.True(t, diagErr.HasError()) {
+		assert.Contains(t, diagErr[0].Detail, "update of genesyscloud_test 1234")
+		assert.Contains(t, diagErr[0].Detail, "Changed attributes: name, password.")
+	}
+
+	diagErr = resource.DeleteContext(context.Background(), d, meta)
+	if assert.True(t, diagErr.HasError()) {
+		assert.Contains(t, diagErr[0].Detail, "delete of genesyscloud_test 1234 was not sent")
+	}
+	assert.Zero(t, calls)
+}
@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+When the validate_references provider setting is enabled, the references of a resource are validated during plan. The attributes
+that reference other resources are the RefAttrs of the exporter of the resource. The objects referenced by the attributes that
+change are looked up with the LookupReferenceFunc of the exporter of the referenced resource type, and must exist and be one of the
+RefKinds of the reference, if any. References to resource types without a LookupReferenceFunc, and references to objects that are
+created in the same apply and are not known yet, are not validated.
+
+Lookups of objects that exist are cached by org for the lifetime of the provider process, so an object referenced by many resources
+is only looked up once, and provider aliases configured for different orgs don't share their lookups. Objects that are not found are
+looked up again every time, as objects created earlier in the same apply may not be found until the API is consistent.
+*/
+
+// The value of attributes that will be known after apply, see hcl2shim.UnknownVariableValue of the plugin SDK
+const unknownVariableValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+type referenceLookup struct {
+	kind  string
+	found bool
+}
+
+var referenceLookups sync.Map
+
+// withReferenceValidation returns a copy of a resource that validates its references during plan
+func withReferenceValidation(resourceType string, resource *schema.Resource) *schema.Resource {
+	wrapped := *resource
+	validate := validateReferences(resourceType)
+	customizeDiff := resource.CustomizeDiff
+	wrapped.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if customizeDiff != nil {
+			if err := customizeDiff(ctx, d, meta); err != nil {
+				return err
+			}
+		}
+		return validate(ctx, d, meta)
+	}
+	return &wrapped
+}
+
+func validateReferences(resourceType string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		providerMeta, ok := meta.(*ProviderMeta)
+		if !ok || !providerMeta.ValidateReferences {
+			return nil
+		}
+		exporters := resourceExporter.GetResourceExporters()
+		exporter := exporters[resourceType]
+		if exporter == nil {
+			return nil
+		}
+		ctx = WithClientPool(ctx, meta)
+		orgId := ""
+		if providerMeta.Organization != nil && providerMeta.Organization.Id != nil {
+			orgId = *providerMeta.Organization.Id
+		}
+
+		attributes := make([]string, 0, len(exporter.RefAttrs))
+		for attribute := range exporter.RefAttrs {
+			attributes = append(attributes, attribute)
+		}
+		sort.Strings(attributes)
+
+		var errs []error
+		for _, attribute := range attributes {
+			settings := exporter.RefAttrs[attribute]
+			if settings == nil {
+				continue
+			}
+			refExporter := exporters[settings.RefType]
+			if refExporter == nil || refExporter.LookupReferenceFunc == nil {
+				continue
+			}
+			path := strings.Split(attribute, ".")
+			if !d.HasChange(path[0]) || !d.NewValueKnown(path[0]) {
+				continue
+			}
+			for _, id := range referencedIds(d.Get(path[0]), path[1:]) {
+				if id == "" || id == unknownVariableValue || lists.ItemInSlice(id, settings.AltValues) {
+					continue
+				}
+				if err := validateReference(ctx, orgId, attribute, settings, refExporter.LookupReferenceFunc, id); err != nil {
+					errs = append(errs, err)
+				}
+			}
+		}
+		return errors.Join(errs...)
+	}
+}
+
+// validateReference looks up a referenced object of an org and returns an error when it doesn't exist or isn't of one of the
+// expected kinds
+func validateReference(ctx context.Context, orgId string, attribute string, settings *resourceExporter.RefAttrSettings, lookupFunc resourceExporter.LookupReferenceFunc, id string) error {
+	cacheKey := orgId + "/" + settings.RefType + "/" + id
+	var lookup referenceLookup
+	if cached, ok := referenceLookups.Load(cacheKey); ok {
+		lookup = cached.(referenceLookup)
+	} else {
+		kind, found, diagErr := lookupFunc(ctx, id)
+		if diagErr.HasError() {
+			return fmt.Errorf("failed to look up %s %s referenced by %s: %s", settings.RefType, id, attribute, diagErr[0].Summary)
+		}
+		lookup = referenceLookup{kind: kind, found: found}
+		// Objects created earlier in the same apply may not be found until the API is consistent, so misses are looked up again
+		if found {
+			referenceLookups.Store(cacheKey, lookup)
+		}
+	}
+
+	if !lookup.found {
+		return fmt.Errorf("%s references %s %s, which does not exist", attribute, settings.RefType, id)
+	}
+	if len(settings.RefKinds) > 0 && !lists.ItemInSlice(lookup.kind, settings.RefKinds) {
+		return fmt.Errorf("%s references %s %s of type %s, expected one of %s", attribute, settings.RefType, id, lookup.kind, strings.Join(settings.RefKinds, ", "))
+	}
+	return nil
+}
+
+// referencedIds returns the IDs of a reference attribute. Attributes of nested objects are found by following the path of the
+// attribute, e.g. member_groups.member_group_id.
+func referencedIds(value interface{}, path []string) []string {
+	var ids []string
+	switch v := value.(type) {
+	case string:
+		if len(path) == 0 {
+			ids = append(ids, v)
+		}
+	case *schema.Set:
+		return referencedIds(v.List(), path)
+	case []interface{}:
+		for _, item := range v {
+			ids = append(ids, referencedIds(item, path)...)
+		}
+	case map[string]interface{}:
+		if len(path) > 0 {
+			ids = append(ids, referencedIds(v[path[0]], path[1:])...)
+		}
+	}
+	return ids
+}
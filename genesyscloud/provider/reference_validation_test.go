@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestUnitReferenceValidation verifies references are validated during plan when validate_references is enabled
+func TestUnitReferenceValidation(t *testing.T) {
+	flows := map[string]string{"inbound": "INBOUNDCALL", "inqueue": "INQUEUECALL"}
+	users := map[string]bool{"user": true}
+	lookups := 0
+	exporters := resourceExporter.GetResourceExporters()
+	resourceExporter.SetRegisterExporter(map[string]*resourceExporter.ResourceExporter{
+		"test_ivr": {
+			RefAttrs: map[string]*resourceExporter.RefAttrSettings{
+				"flow_id":         {RefType: "test_flow", RefKinds: []string{"INBOUNDCALL"}},
+				"members.user_id": {RefType: "test_user"},
+				"schedule_id":     {RefType: "test_schedule"},
+			},
+		},
+		"test_flow": {
+			LookupReferenceFunc: func(_ context.Context, id string) (string, bool, diag.Diagnostics) {
+				lookups++
+				kind, ok := flows[id]
+				return kind, ok, nil
+			},
+		},
+		"test_user": {
+			LookupReferenceFunc: func(_ context.Context, id string) (string, bool, diag.Diagnostics) {
+				return "", users[id], nil
+			},
+		},
+	})
+	defer resourceExporter.SetRegisterExporter(exporters)
+
+	resource := withReferenceValidation("test_ivr", &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"flow_id":     {Type: schema.TypeString, Optional: true},
+			"schedule_id": {Type: schema.TypeString, Optional: true},
+			"members": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+					"user_id": {Type: schema.TypeString, Optional: true},
+				}},
+			},
+		},
+	})
+	plan := func(meta *ProviderMeta, config map[string]interface{}) error {
+		_, err := resource.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), meta)
+		return err
+	}
+	enabled := &ProviderMeta{ValidateReferences: true, Organization: &platformclientv2.Organization{Id: platformclientv2.String("org")}}
+
+	assert.NoError(t, plan(enabled, map[string]interface{}{
+		"flow_id":     "inbound",
+		"schedule_id": "missing",
+		"members":     []interface{}{map[string]interface{}{"user_id": "user"}},
+	}))
+	assert.NoError(t, plan(&ProviderMeta{}, map[string]interface{}{"flow_id": "missing"}))
+
+	err := plan(enabled, map[string]interface{}{
+		"flow_id": "inqueue",
+		"members": []interface{}{map[string]interface{}{"user_id": "deleted"}},
+	})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "flow_id references test_flow inqueue of type INQUEUECALL, expected one of INBOUNDCALL")
+		assert.Contains(t, err.Error(), "members.user_id references test_user deleted, which does not exist")
+	}
+
+	// Lookups are cached
+	assert.NoError(t, plan(enabled, map[string]interface{}{"flow_id": "inbound"}))
+	assert.Equal(t, 2, lookups)
+
+	// Lookups are not shared with the provider instances of other orgs
+	otherOrg := &ProviderMeta{ValidateReferences: true, Organization: &platformclientv2.Organization{Id: platformclientv2.String("other")}}
+	assert.NoError(t, plan(otherOrg, map[string]interface{}{"flow_id": "inbound"}))
+	assert.Equal(t, 3, lookups)
+
+	// Objects that were not found are looked up again, e.g. once an object created in the same apply is found
+	late := map[string]interface{}{"members": []interface{}{map[string]interface{}{"user_id": "late"}}}
+	assert.Error(t, plan(enabled, late))
+	users["late"] = true
+	assert.NoError(t, plan(enabled, late))
+}
@@ -0,0 +1,191 @@
+package provider
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+The retry policy of the provider is set with the retry block of the provider config. It is honoured by the retries of the SDK and by
+the retry helpers of the util package. Settings that are not set keep the defaults of each of them, e.g. the SDK retries a request 20
+times and util.RetryWhen tries a call 10 times. Every provider instance has its own policy, which the retry helpers resolve from
+the context of the resource function, see WithRetryPolicy.
+
+The SDK decides itself which responses are retried, namely 429 and 5xx responses, so the retryable status codes of the policy only
+extend the status codes retried by util.RetryWhen. The backoff of the SDK can't be randomised either, so the jitter of the policy only
+applies to util.RetryWhen.
+*/
+
+const (
+	defaultSdkRetryMax     = 20
+	defaultSdkRetryWaitMin = time.Second
+	defaultSdkRetryWaitMax = 30 * time.Second
+)
+
+type RetryPolicy struct {
+	MaxAttempts           int
+	BackoffBase           time.Duration
+	BackoffMax            time.Duration
+	Jitter                float64
+	RetryableStatusCodes  []int
+	ReadAfterWriteTimeout time.Duration
+}
+
+type retryPolicyContextKey struct{}
+
+// WithRetryPolicy returns a copy of the context carrying a retry policy. The resource functions wrapped with the pooled client
+// functions are passed a context carrying the retry policy of their provider instance.
+func WithRetryPolicy(ctx context.Context, policy *RetryPolicy) context.Context {
+	if policy == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, retryPolicyContextKey{}, policy)
+}
+
+// RetryPolicyFromContext returns the retry policy carried by the context, or the defaults when it doesn't carry one
+func RetryPolicyFromContext(ctx context.Context) *RetryPolicy {
+	if ctx != nil {
+		if policy, ok := ctx.Value(retryPolicyContextKey{}).(*RetryPolicy); ok {
+			return policy
+		}
+	}
+	return &RetryPolicy{}
+}
+
+func retryPolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Retry policy of the API requests made by the provider. Settings that are not set keep the defaults of the provider.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_attempts": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "Max number of attempts of an API request, including the first attempt. Defaults to 21 for requests retried by the SDK, and to 10 for updates retried on version conflicts.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"backoff_base_ms": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "Number of milliseconds to wait before the first retry. The wait doubles on every retry up to `backoff_max_ms`. Defaults to 1000.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"backoff_max_ms": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "Max number of milliseconds to wait before a retry. Defaults to 30000 for requests retried by the SDK, and to 1000 for updates retried on version conflicts unless `backoff_base_ms` is set.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"jitter": {
+					Type:         schema.TypeFloat,
+					Optional:     true,
+					Description:  "Fraction of the wait before a retry, between 0 and 1, that is randomised so that parallel requests don't retry at once. Only applies to updates retried on version conflicts, as the wait of requests retried by the SDK can't be randomised. Defaults to 0.",
+					ValidateFunc: validation.FloatBetween(0, 1),
+				},
+				"retryable_status_codes": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "HTTP status codes that updates retried on version conflicts are also retried on, e.g. 404 for objects that are not yet visible after they were created. The SDK always retries 429 and 5xx responses.",
+					Elem: &schema.Schema{
+						Type:         schema.TypeInt,
+						ValidateFunc: validation.IntBetween(100, 599),
+					},
+				},
+				"read_after_write_timeout": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "Max number of seconds that a resource is read again after it was created or updated, until the API returns it. When it is not set, the read is retried for 5 minutes and restarted while it times out.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func readRetryPolicy(data *schema.ResourceData) *RetryPolicy {
+	policy := &RetryPolicy{}
+	retryList, _ := data.Get("retry").([]interface{})
+	if len(retryList) == 0 || retryList[0] == nil {
+		return policy
+	}
+	retry := retryList[0].(map[string]interface{})
+
+	policy.MaxAttempts = retry["max_attempts"].(int)
+	policy.BackoffBase = time.Duration(retry["backoff_base_ms"].(int)) * time.Millisecond
+	policy.BackoffMax = time.Duration(retry["backoff_max_ms"].(int)) * time.Millisecond
+	policy.Jitter = retry["jitter"].(float64)
+	for _, code := range retry["retryable_status_codes"].(*schema.Set).List() {
+		policy.RetryableStatusCodes = append(policy.RetryableStatusCodes, code.(int))
+	}
+	policy.ReadAfterWriteTimeout = time.Duration(retry["read_after_write_timeout"].(int)) * time.Second
+	return policy
+}
+
+// Attempts returns the max number of attempts of a call, or the default of the caller when it isn't set
+func (p *RetryPolicy) Attempts(defaultAttempts int) int {
+	if p.MaxAttempts > 0 {
+		return p.MaxAttempts
+	}
+	return defaultAttempts
+}
+
+// Backoff returns the wait before a retry, starting at 1 for the first retry. The wait doubles on every retry from the backoff base
+// up to the backoff max, and the jitter fraction of it is randomised. The defaults of the caller are used when they aren't set.
+func (p *RetryPolicy) Backoff(retryNumber int, defaultBase time.Duration, defaultMax time.Duration) time.Duration {
+	base := defaultBase
+	if p.BackoffBase > 0 {
+		base = p.BackoffBase
+	}
+	maxWait := defaultMax
+	if p.BackoffMax > 0 {
+		maxWait = p.BackoffMax
+	} else if p.BackoffBase > 0 {
+		maxWait = defaultSdkRetryWaitMax
+	}
+
+	wait := float64(base) * math.Pow(2, float64(retryNumber-1))
+	if wait > float64(maxWait) {
+		wait = float64(maxWait)
+	}
+	wait -= wait * p.Jitter * rand.Float64()
+	return time.Duration(wait)
+}
+
+// IsRetryableStatus returns true when a status code is one of the retryable status codes of the policy
+func (p *RetryPolicy) IsRetryableStatus(statusCode int) bool {
+	for _, code := range p.RetryableStatusCodes {
+		if code == statusCode {
+			return true
+		}
+	}
+	return false
+}
+
+// ReadTimeout returns the read after write timeout, or the default of the caller when it isn't set
+func (p *RetryPolicy) ReadTimeout(defaultTimeout time.Duration) time.Duration {
+	if p.ReadAfterWriteTimeout > 0 {
+		return p.ReadAfterWriteTimeout
+	}
+	return defaultTimeout
+}
+
+func (p *RetryPolicy) sdkRetryWaitMin() time.Duration {
+	if p.BackoffBase > 0 {
+		return p.BackoffBase
+	}
+	return defaultSdkRetryWaitMin
+}
+
+func (p *RetryPolicy) sdkRetryWaitMax() time.Duration {
+	if p.BackoffMax > 0 {
+		return p.BackoffMax
+	}
+	return defaultSdkRetryWaitMax
+}
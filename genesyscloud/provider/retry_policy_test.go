@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestUnitRetryPolicy verifies the retry block is read and unset settings keep the defaults of the caller
+func TestUnitRetryPolicy(t *testing.T) {
+	defaults := readRetryPolicy(schema.TestResourceDataRaw(t, providerSchema(), map[string]interface{}{}))
+	assert.Equal(t, 10, defaults.Attempts(10))
+	assert.Equal(t, time.Second, defaults.Backoff(5, time.Second, time.Second))
+	assert.Equal(t, 5*time.Minute, defaults.ReadTimeout(5*time.Minute))
+	assert.Equal(t, defaultSdkRetryWaitMax, defaults.sdkRetryWaitMax())
+
+	policy := readRetryPolicy(schema.TestResourceDataRaw(t, providerSchema(), map[string]interface{}{
+		"retry": []interface{}{map[string]interface{}{
+			"max_attempts":             3,
+			"backoff_base_ms":          100,
+			"backoff_max_ms":           300,
+			"retryable_status_codes":   []interface{}{404},
+			"read_after_write_timeout": 60,
+		}},
+	}))
+	assert.Equal(t, 3, policy.Attempts(10))
+	assert.Equal(t, 100*time.Millisecond, policy.Backoff(1, time.Second, time.Second))
+	assert.Equal(t, 200*time.Millisecond, policy.Backoff(2, time.Second, time.Second))
+	assert.Equal(t, 300*time.Millisecond, policy.Backoff(3, time.Second, time.Second))
+	assert.True(t, policy.IsRetryableStatus(404))
+	assert.False(t, policy.IsRetryableStatus(409))
+	assert.Equal(t, time.Minute, policy.ReadTimeout(5*time.Minute))
+
+	policy.Jitter = 0.5
+	for i := 0; i < 10; i++ {
+		wait := policy.Backoff(1, time.Second, time.Second)
+		assert.True(t, wait > 50*time.Millisecond && wait <= 100*time.Millisecond, "wait %v out of range", wait)
+	}
+
+	// The policy is resolved from the context of the provider instance, and defaults to the callers' defaults without one
+	ctx := WithClientPool(context.Background(), &ProviderMeta{RetryPolicy: policy})
+	assert.Same(t, policy, RetryPolicyFromContext(ctx))
+	assert.Equal(t, 10, RetryPolicyFromContext(context.Background()).Attempts(10))
+}
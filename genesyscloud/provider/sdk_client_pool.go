@@ -2,9 +2,15 @@ package provider
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 	"log"
+	"net/http"
+	"sort"
 	"sync"
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,33 +21,138 @@ import (
 // acquired at the beginning of any resource operation and released on completion.
 // This has the benefit of ensuring we don't issue too many concurrent requests and also
 // increases throughput as each token will have its own rate limit.
+// Every provider configuration has its own Pool, so provider aliases configured for different
+// orgs never share clients or proxies.
+// When token_pool_adaptive is set, the number of clients that can be acquired at once is limited adaptively:
+// the limit is halved when the API rate limits a client and grows back by one client at a time while requests succeed.
 type SDKClientPool struct {
 	Pool chan *platformclientv2.Configuration
+	// DefaultConfig is used by anything that doesn't acquire a client from the Pool
+	DefaultConfig *platformclientv2.Configuration
+	proxies       *proxyCache
+
+	// acquireTimeout limits how long acquire waits for a client. Zero waits until the context is done.
+	acquireTimeout time.Duration
+	adaptive       bool
+
+	mutex sync.Mutex
+	// released is closed and replaced whenever a client is released or the limit grows, to wake up waiting acquires
+	released     chan struct{}
+	limit        int
+	inUse        int
+	successes    int
+	lastDecrease time.Time
+	metrics      ClientPoolMetrics
+}
+
+// ClientPoolMetrics describes the usage of a client Pool since it was created
+type ClientPoolMetrics struct {
+	Size             int     `json:"size"`
+	Limit            int     `json:"limit"`
+	InUse            int     `json:"in_use"`
+	MaxInUse         int     `json:"max_in_use"`
+	Acquired         int64   `json:"acquired"`
+	Timeouts         int64   `json:"timeouts"`
+	TotalWaitSeconds float64 `json:"total_wait_seconds"`
+	MaxWaitSeconds   float64 `json:"max_wait_seconds"`
+	LimitDecreases   int64   `json:"limit_decreases"`
+	LimitIncreases   int64   `json:"limit_increases"`
 }
 
-var SdkClientPool *SDKClientPool
-var SdkClientPoolErr diag.Diagnostics
-var Once sync.Once
+// The limit of a Pool is decreased at most once per cooldown, so a burst of rate limited responses
+// to requests that were sent at the same time only halves it once
+const clientPoolLimitCooldown = 5 * time.Second
+
+var (
+	sdkClientPools      = make(map[string]*SDKClientPool)
+	sdkClientPoolsMutex sync.Mutex
+
+	// The Pool of the first provider configuration. It is used when a Pool is not passed in the provider meta or context.
+	defaultClientPool *SDKClientPool
+)
 
-// InitSDKClientPool creates a new Pool of Clients with the given provider config
+// InitSDKClientPool returns the Pool of Clients for the given provider config, creating it on first use.
+// Provider instances with identical configs share a Pool.
 // This must be called during provider initialization before the Pool is used
-func InitSDKClientPool(max int, version string, providerConfig *schema.ResourceData) diag.Diagnostics {
-	Once.Do(func() {
-		log.Print("Initializing default SDK client.")
-		// Initialize the default config for tests and anything else that doesn't use the Pool
-		err := InitClientConfig(providerConfig, version, platformclientv2.GetDefaultConfiguration())
-		if err != nil {
-			SdkClientPoolErr = err
-			return
-		}
+func InitSDKClientPool(max int, version string, providerConfig *schema.ResourceData) (*SDKClientPool, diag.Diagnostics) {
+	key := providerConfigKey(providerConfig)
+
+	sdkClientPoolsMutex.Lock()
+	defer sdkClientPoolsMutex.Unlock()
+	if pool, ok := sdkClientPools[key]; ok {
+		return pool, nil
+	}
+
+	// The default config of the SDK is initialized for the first provider config for tests and anything else that
+	// doesn't use the Pool
+	defaultConfig := platformclientv2.NewConfiguration()
+	if defaultClientPool == nil {
+		defaultConfig = platformclientv2.GetDefaultConfiguration()
+	}
+
+	log.Print("Initializing default SDK client.")
+	err := InitClientConfig(providerConfig, version, defaultConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("Initializing %d SDK clients in the Pool.", max)
+	pool := &SDKClientPool{
+		Pool:           make(chan *platformclientv2.Configuration, max),
+		DefaultConfig:  defaultConfig,
+		proxies:        &proxyCache{},
+		acquireTimeout: time.Duration(providerConfig.Get("token_pool_acquire_timeout").(int)) * time.Second,
+		adaptive:       providerConfig.Get("token_pool_adaptive").(bool),
+		released:       make(chan struct{}),
+		limit:          max,
+		metrics:        ClientPoolMetrics{Size: max},
+	}
+	err = pool.preFill(providerConfig, version)
+	if err != nil {
+		return nil, err
+	}
+	sdkClientPools[key] = pool
+	if defaultClientPool == nil {
+		defaultClientPool = pool
+	}
+	return pool, nil
+}
+
+// providerConfigKey identifies a provider configuration by the values of its attributes
+func providerConfigKey(providerConfig *schema.ResourceData) string {
+	keys := make([]string, 0)
+	for key := range providerSchema() {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
 
-		log.Printf("Initializing %d SDK clients in the Pool.", max)
-		SdkClientPool = &SDKClientPool{
-			Pool: make(chan *platformclientv2.Configuration, max),
+	hash := sha256.New()
+	for _, key := range keys {
+		fmt.Fprintf(hash, "%s=%#v\n", key, providerConfigValue(providerConfig.Get(key)))
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// providerConfigValue converts the sets of a provider config into lists, as the value of a set is a pointer
+func providerConfigValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case *schema.Set:
+		return providerConfigValue(v.List())
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			result[i] = providerConfigValue(item)
+		}
+		return result
+	case map[string]interface{}:
+		result := make([]string, 0, len(v))
+		for key, item := range v {
+			result = append(result, fmt.Sprintf("%s=%#v", key, providerConfigValue(item)))
 		}
-		SdkClientPoolErr = SdkClientPool.preFill(providerConfig, version)
-	})
-	return SdkClientPoolErr
+		sort.Strings(result)
+		return result
+	}
+	return value
 }
 
 func (p *SDKClientPool) preFill(providerConfig *schema.ResourceData, version string) diag.Diagnostics {
@@ -66,8 +177,10 @@ func (p *SDKClientPool) preFill(providerConfig *schema.ResourceData, version str
 				return
 			}
 		}()
+		p.register(sdkConfig)
 		p.Pool <- sdkConfig
 	}
+	p.register(p.DefaultConfig)
 	go func() {
 		wg.Wait()
 		close(wgDone)
@@ -82,24 +195,154 @@ func (p *SDKClientPool) preFill(providerConfig *schema.ResourceData, version str
 	}
 }
 
-func (p *SDKClientPool) acquire() *platformclientv2.Configuration {
-	return <-p.Pool
+// clientConfigPools maps every client config to the Pool that owns it, so responses to the requests of a client can adjust
+// the limit of its Pool
+var clientConfigPools sync.Map
+
+func (p *SDKClientPool) register(clientConfig *platformclientv2.Configuration) {
+	p.proxies.register(clientConfig)
+	clientConfigPools.Store(clientConfig, p)
+}
+
+// unregister removes a client config that is no longer used by the Pool from the maps of client configs
+func (p *SDKClientPool) unregister(clientConfig *platformclientv2.Configuration) {
+	unregisterProxies(clientConfig)
+	clientTokens.Delete(clientConfig)
+	clientConfigPools.Delete(clientConfig)
+}
+
+// acquire waits for a client until one is available within the limit of the Pool, the acquire timeout passes or the
+// context of the resource operation is done
+func (p *SDKClientPool) acquire(ctx context.Context) (*platformclientv2.Configuration, diag.Diagnostics) {
+	start := time.Now()
+	var timeout <-chan time.Time
+	if p.acquireTimeout > 0 {
+		timer := time.NewTimer(p.acquireTimeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	for {
+		p.mutex.Lock()
+		if p.inUse < p.limit {
+			select {
+			case c := <-p.Pool:
+				p.inUse++
+				p.recordAcquired(time.Since(start))
+				p.mutex.Unlock()
+				syncClientToken(c)
+				return c, nil
+			default:
+			}
+		}
+		released := p.released
+		p.mutex.Unlock()
+
+		select {
+		case <-released:
+		case <-ctx.Done():
+			return nil, p.acquireFailed(ctx.Err(), start)
+		case <-timeout:
+			return nil, p.acquireFailed(fmt.Errorf("timed out after %v", p.acquireTimeout), start)
+		}
+	}
+}
+
+func (p *SDKClientPool) acquireFailed(err error, start time.Time) diag.Diagnostics {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.metrics.Timeouts++
+	return diag.Errorf("failed to acquire an SDK client after waiting %v: %v. %d of %d clients are in use with a limit of %d",
+		time.Since(start).Round(time.Millisecond), err, p.inUse, cap(p.Pool), p.limit)
+}
+
+// recordAcquired must be called with the mutex held
+func (p *SDKClientPool) recordAcquired(wait time.Duration) {
+	p.metrics.Acquired++
+	p.metrics.TotalWaitSeconds += wait.Seconds()
+	if wait.Seconds() > p.metrics.MaxWaitSeconds {
+		p.metrics.MaxWaitSeconds = wait.Seconds()
+	}
+	if p.inUse > p.metrics.MaxInUse {
+		p.metrics.MaxInUse = p.inUse
+	}
 }
 
 func (p *SDKClientPool) release(c *platformclientv2.Configuration) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.inUse--
 	select {
 	case p.Pool <- c:
 	default:
 		// Pool is full. Don't put it back in the Pool
+		p.unregister(c)
 	}
+	p.wakeWaiters()
+}
+
+// wakeWaiters must be called with the mutex held
+func (p *SDKClientPool) wakeWaiters() {
+	close(p.released)
+	p.released = make(chan struct{})
+}
+
+// observeResponse adjusts the limit of the Pool that owns the client config to the response of one of its requests
+func observeResponse(clientConfig *platformclientv2.Configuration, response *http.Response) {
+	if pool, ok := clientConfigPools.Load(clientConfig); ok {
+		pool.(*SDKClientPool).observe(response.StatusCode, time.Now())
+	}
+}
+
+// observe halves the limit of the Pool when a request is rate limited, and increases it by one client once as many
+// requests as the limit have succeeded since the limit last changed
+func (p *SDKClientPool) observe(statusCode int, now time.Time) {
+	if !p.adaptive {
+		return
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if statusCode == http.StatusTooManyRequests || statusCode == http.StatusServiceUnavailable {
+		p.successes = 0
+		if p.limit == 1 || now.Sub(p.lastDecrease) < clientPoolLimitCooldown {
+			return
+		}
+		p.limit = p.limit / 2
+		p.lastDecrease = now
+		p.metrics.LimitDecreases++
+		log.Printf("Decreased the SDK client limit to %d of %d after response %d", p.limit, cap(p.Pool), statusCode)
+		return
+	}
+
+	p.successes++
+	if p.limit == cap(p.Pool) || p.successes < p.limit || now.Sub(p.lastDecrease) < clientPoolLimitCooldown {
+		return
+	}
+	p.limit++
+	p.successes = 0
+	p.metrics.LimitIncreases++
+	log.Printf("Increased the SDK client limit to %d of %d", p.limit, cap(p.Pool))
+	p.wakeWaiters()
+}
+
+// Metrics returns the usage of the Pool
+func (p *SDKClientPool) Metrics() ClientPoolMetrics {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	metrics := p.metrics
+	metrics.Limit = p.limit
+	metrics.InUse = p.inUse
+	return metrics
 }
 
 type resContextFunc func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics
 type GetAllConfigFunc func(context.Context, *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics)
 type GetCustomConfigFunc func(context.Context, *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, *resourceExporter.DependencyResource, diag.Diagnostics)
+type LookupReferenceConfigFunc func(context.Context, *platformclientv2.Configuration, string) (string, bool, diag.Diagnostics)
 
 func CreateWithPooledClient(method resContextFunc) schema.CreateContextFunc {
-	return schema.CreateContextFunc(runWithPooledClient(method))
+	return schema.CreateContextFunc(withReadOnlyGuard(OperationCreate, runWithPooledClient(method)))
 }
 
 func ReadWithPooledClient(method resContextFunc) schema.ReadContextFunc {
@@ -107,19 +350,28 @@ func ReadWithPooledClient(method resContextFunc) schema.ReadContextFunc {
 }
 
 func UpdateWithPooledClient(method resContextFunc) schema.UpdateContextFunc {
-	return schema.UpdateContextFunc(runWithPooledClient(method))
+	return schema.UpdateContextFunc(withReadOnlyGuard(OperationUpdate, runWithPooledClient(method)))
 }
 
 func DeleteWithPooledClient(method resContextFunc) schema.DeleteContextFunc {
-	return schema.DeleteContextFunc(runWithPooledClient(method))
+	return schema.DeleteContextFunc(withReadOnlyGuard(OperationDelete, runWithPooledClient(method)))
 }
 
 // Inject a pooled SDK client connection into a resource method's meta argument
 // and automatically return it to the Pool on completion
 func runWithPooledClient(method resContextFunc) resContextFunc {
 	return func(ctx context.Context, r *schema.ResourceData, meta interface{}) diag.Diagnostics {
-		clientConfig := SdkClientPool.acquire()
-		defer SdkClientPool.release(clientConfig)
+		pool := meta.(*ProviderMeta).clientPool()
+		clientConfig, diagErr := pool.acquire(ctx)
+		if diagErr != nil {
+			return diagErr
+		}
+		defer pool.release(clientConfig)
+		labelClient(clientConfig, ctx)
+		defer unlabelClient(clientConfig)
+		limitClient(clientConfig, meta.(*ProviderMeta).rateLimiter)
+		defer unlimitClient(clientConfig)
+		ctx = WithRetryPolicy(ctx, meta.(*ProviderMeta).RetryPolicy)
 
 		// Check if the request has been cancelled
 		select {
@@ -135,11 +387,20 @@ func runWithPooledClient(method resContextFunc) resContextFunc {
 	}
 }
 
-// Inject a pooled SDK client connection into an exporter's getAll* method
+// Inject a pooled SDK client connection into an exporter's getAll* method. The client is acquired
+// from the Pool carried by the context, see WithClientPool.
 func GetAllWithPooledClient(method GetAllConfigFunc) resourceExporter.GetAllResourcesFunc {
 	return func(ctx context.Context) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
-		clientConfig := SdkClientPool.acquire()
-		defer SdkClientPool.release(clientConfig)
+		pool := clientPoolFromContext(ctx)
+		clientConfig, diagErr := pool.acquire(ctx)
+		if diagErr != nil {
+			return nil, diagErr
+		}
+		defer pool.release(clientConfig)
+		labelClient(clientConfig, ctx)
+		defer unlabelClient(clientConfig)
+		limitClient(clientConfig, rateLimiterFromContext(ctx))
+		defer unlimitClient(clientConfig)
 
 		// Check if the request has been cancelled
 		select {
@@ -154,8 +415,16 @@ func GetAllWithPooledClient(method GetAllConfigFunc) resourceExporter.GetAllReso
 
 func GetAllWithPooledClientCustom(method GetCustomConfigFunc) resourceExporter.GetAllCustomResourcesFunc {
 	return func(ctx context.Context) (resourceExporter.ResourceIDMetaMap, *resourceExporter.DependencyResource, diag.Diagnostics) {
-		clientConfig := SdkClientPool.acquire()
-		defer SdkClientPool.release(clientConfig)
+		pool := clientPoolFromContext(ctx)
+		clientConfig, diagErr := pool.acquire(ctx)
+		if diagErr != nil {
+			return nil, nil, diagErr
+		}
+		defer pool.release(clientConfig)
+		labelClient(clientConfig, ctx)
+		defer unlabelClient(clientConfig)
+		limitClient(clientConfig, rateLimiterFromContext(ctx))
+		defer unlimitClient(clientConfig)
 
 		// Check if the request has been cancelled
 		select {
@@ -167,3 +436,73 @@ func GetAllWithPooledClientCustom(method GetCustomConfigFunc) resourceExporter.G
 		return method(ctx, clientConfig)
 	}
 }
+
+// Inject a pooled SDK client connection into an exporter's lookup method for references. The client is acquired
+// from the Pool carried by the context, see WithClientPool.
+func LookupReferenceWithPooledClient(method LookupReferenceConfigFunc) resourceExporter.LookupReferenceFunc {
+	return func(ctx context.Context, id string) (string, bool, diag.Diagnostics) {
+		pool := clientPoolFromContext(ctx)
+		clientConfig, diagErr := pool.acquire(ctx)
+		if diagErr != nil {
+			return "", false, diagErr
+		}
+		defer pool.release(clientConfig)
+		labelClient(clientConfig, ctx)
+		defer unlabelClient(clientConfig)
+		limitClient(clientConfig, rateLimiterFromContext(ctx))
+		defer unlimitClient(clientConfig)
+
+		// Check if the request has been cancelled
+		select {
+		case <-ctx.Done():
+			return "", false, diag.FromErr(ctx.Err()) // Error somewhere, terminate
+		default:
+		}
+
+		return method(ctx, clientConfig, id)
+	}
+}
+
+type clientPoolContextKey struct{}
+
+// WithClientPool returns a copy of the context carrying the client Pool of the provider instance that owns the meta, and the
+// request limit and retry policy of the meta.
+// Exporter functions are not passed the provider meta, so they acquire their client from the Pool in the context.
+func WithClientPool(ctx context.Context, meta interface{}) context.Context {
+	providerMeta, ok := meta.(*ProviderMeta)
+	if !ok {
+		return ctx
+	}
+	if providerMeta.rateLimiter != nil {
+		ctx = context.WithValue(ctx, rateLimiterContextKey{}, providerMeta.rateLimiter)
+	}
+	ctx = WithRetryPolicy(ctx, providerMeta.RetryPolicy)
+	if providerMeta.ClientPool == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, clientPoolContextKey{}, providerMeta.ClientPool)
+}
+
+func clientPoolFromContext(ctx context.Context) *SDKClientPool {
+	if pool, ok := ctx.Value(clientPoolContextKey{}).(*SDKClientPool); ok {
+		return pool
+	}
+	return defaultClientPool
+}
+
+// GetClientPoolMetrics returns the usage of the client Pool of the provider instance that owns the meta, or nil when there is no Pool
+func GetClientPoolMetrics(meta interface{}) *ClientPoolMetrics {
+	providerMeta, ok := meta.(*ProviderMeta)
+	if !ok || providerMeta.clientPool() == nil {
+		return nil
+	}
+	metrics := providerMeta.clientPool().Metrics()
+	return &metrics
+}
+
+func (m *ProviderMeta) clientPool() *SDKClientPool {
+	if m.ClientPool != nil {
+		return m.ClientPool
+	}
+	return defaultClientPool
+}
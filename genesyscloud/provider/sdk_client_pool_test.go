@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+type testProxy struct {
+	clientConfig *platformclientv2.Configuration
+}
+
+func newTestProxy(clientConfig *platformclientv2.Configuration) *testProxy {
+	return &testProxy{clientConfig: clientConfig}
+}
+
+// TestUnitGetProxy verifies the client configs of a provider instance share proxies and other provider instances get their own
+func TestUnitGetProxy(t *testing.T) {
+	devPool := &SDKClientPool{proxies: &proxyCache{}}
+	prodPool := &SDKClientPool{proxies: &proxyCache{}}
+	devConfigs := []*platformclientv2.Configuration{platformclientv2.NewConfiguration(), platformclientv2.NewConfiguration()}
+	prodConfig := platformclientv2.NewConfiguration()
+	for _, config := range devConfigs {
+		devPool.proxies.register(config)
+	}
+	prodPool.proxies.register(prodConfig)
+
+	devProxy := GetProxy(devConfigs[0], newTestProxy)
+	assert.Same(t, devProxy, GetProxy(devConfigs[1], newTestProxy))
+	assert.Same(t, devConfigs[0], devProxy.clientConfig)
+
+	prodProxy := GetProxy(prodConfig, newTestProxy)
+	assert.NotSame(t, devProxy, prodProxy)
+	assert.Same(t, prodConfig, prodProxy.clientConfig)
+
+	// Client configs that don't belong to a provider instance get proxies of their own, which are reused
+	unpooledConfig := platformclientv2.NewConfiguration()
+	defer unregisterProxies(unpooledConfig)
+	unpooledProxy := GetProxy(unpooledConfig, newTestProxy)
+	assert.Same(t, unpooledConfig, unpooledProxy.clientConfig)
+	assert.Same(t, unpooledProxy, GetProxy(unpooledConfig, newTestProxy))
+	assert.NotSame(t, devProxy, unpooledProxy)
+
+	// Client configs that are dropped from a pool are no longer mapped to its proxies
+	devPool.unregister(devConfigs[1])
+	assert.NotSame(t, devProxy, GetProxy(devConfigs[1], newTestProxy))
+	assert.Same(t, devProxy, GetProxy(devConfigs[0], newTestProxy))
+}
+
+// TestUnitProviderConfigKey verifies provider configs for different orgs are given different client pools
+func TestUnitProviderConfigKey(t *testing.T) {
+	config := func(region string, proxyHost string) *schema.ResourceData {
+		return schema.TestResourceDataRaw(t, providerSchema(), map[string]interface{}{
+			"oauthclient_id":     "client",
+			"oauthclient_secret": "secret",
+			"aws_region":         region,
+			"proxy":              []interface{}{map[string]interface{}{"host": proxyHost, "port": "8080"}},
+		})
+	}
+
+	assert.Equal(t, providerConfigKey(config("us-east-1", "proxy")), providerConfigKey(config("us-east-1", "proxy")))
+	assert.NotEqual(t, providerConfigKey(config("us-east-1", "proxy")), providerConfigKey(config("eu-west-1", "proxy")))
+	assert.NotEqual(t, providerConfigKey(config("us-east-1", "proxy")), providerConfigKey(config("us-east-1", "other-proxy")))
+}
+
+func newTestClientPool(size int) *SDKClientPool {
+	pool := &SDKClientPool{
+		Pool:     make(chan *platformclientv2.Configuration, size),
+		proxies:  &proxyCache{},
+		adaptive: true,
+		released: make(chan struct{}),
+		limit:    size,
+		metrics:  ClientPoolMetrics{Size: size},
+	}
+	for i := 0; i < size; i++ {
+		pool.Pool <- platformclientv2.NewConfiguration()
+	}
+	return pool
+}
+
+// TestUnitClientPoolAdaptiveLimit verifies the limit of a pool is halved when requests are rate limited and grows back while they succeed
+func TestUnitClientPoolAdaptiveLimit(t *testing.T) {
+	pool := newTestClientPool(8)
+	now := time.Now()
+
+	pool.observe(http.StatusTooManyRequests, now)
+	assert.Equal(t, 4, pool.Metrics().Limit)
+	// Responses to requests sent before the limit decreased don't decrease it again
+	pool.observe(http.StatusTooManyRequests, now.Add(time.Second))
+	assert.Equal(t, 4, pool.Metrics().Limit)
+
+	now = now.Add(clientPoolLimitCooldown)
+	for i := 0; i < 4; i++ {
+		pool.observe(http.StatusOK, now)
+	}
+	assert.Equal(t, 5, pool.Metrics().Limit)
+
+	metrics := pool.Metrics()
+	assert.Equal(t, int64(1), metrics.LimitDecreases)
+	assert.Equal(t, int64(1), metrics.LimitIncreases)
+}
+
+// TestUnitClientPoolAcquire verifies clients are only acquired within the limit and acquires wait until the context is done
+func TestUnitClientPoolAcquire(t *testing.T) {
+	pool := newTestClientPool(2)
+	pool.limit = 1
+
+	client, diagErr := pool.acquire(context.Background())
+	assert.Nil(t, diagErr)
+	assert.Equal(t, 1, pool.Metrics().InUse)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, diagErr = pool.acquire(ctx)
+	assert.True(t, diagErr.HasError())
+	assert.Equal(t, int64(1), pool.Metrics().Timeouts)
+
+	// A released client wakes up a waiting acquire
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		pool.release(client)
+	}()
+	_, diagErr = pool.acquire(context.Background())
+	assert.Nil(t, diagErr)
+	assert.Equal(t, int64(2), pool.Metrics().Acquired)
+}
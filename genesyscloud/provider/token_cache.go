@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// tokenCache stores the access tokens of the provider on disk so that later provider runs can reuse them. Every client config
+// of a provider run claims a slot, so the clients of the pool keep using separate tokens with their own rate limits.
+// A tokenCache without a directory doesn't cache tokens.
+type tokenCache struct {
+	dir string
+}
+
+type cachedToken struct {
+	AccessToken string    `json:"access_token"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+var (
+	tokenCacheSlots      = make(map[string]int)
+	tokenCacheSlotsMutex sync.Mutex
+)
+
+func newTokenCache(dir string) *tokenCache {
+	return &tokenCache{dir: dir}
+}
+
+// claimSlot returns the next unclaimed slot for the tokens of the credentials in this provider run
+func (c *tokenCache) claimSlot(key string) int {
+	tokenCacheSlotsMutex.Lock()
+	defer tokenCacheSlotsMutex.Unlock()
+	slot := tokenCacheSlots[key]
+	tokenCacheSlots[key]++
+	return slot
+}
+
+func (c *tokenCache) path(key string, slot int) string {
+	return filepath.Join(c.dir, fmt.Sprintf("%s-%d.json", key[:32], slot))
+}
+
+// get returns the cached token of a slot if it is valid for longer than the refresh margin
+func (c *tokenCache) get(key string, slot int, now time.Time) *cachedToken {
+	if c.dir == "" {
+		return nil
+	}
+	data, err := os.ReadFile(c.path(key, slot))
+	if err != nil {
+		return nil
+	}
+	token := &cachedToken{}
+	if err := json.Unmarshal(data, token); err != nil || token.AccessToken == "" {
+		return nil
+	}
+	if !token.ExpiresAt.After(now.Add(2 * tokenRefreshMargin)) {
+		return nil
+	}
+	return token
+}
+
+// put writes the token of a slot to the cache. The token is written to a temporary file first, so parallel provider runs never
+// read a partially written token.
+func (c *tokenCache) put(key string, slot int, token *cachedToken) error {
+	if c.dir == "" {
+		return nil
+	}
+	if err := os.MkdirAll(c.dir, 0700); err != nil {
+		return err
+	}
+	data, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp(c.dir, "token-*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), c.path(key, slot))
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
 )
@@ -81,13 +82,13 @@ func newPolicyProxy(clientConfig *platformclientv2.Configuration) *policyProxy {
 	}
 }
 
-// getPolicyProxy acts as a singleton to for the internalProxy.  It also ensures
+// getPolicyProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getPolicyProxy(clientConfig *platformclientv2.Configuration) *policyProxy {
-	if internalProxy == nil {
-		internalProxy = newPolicyProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newPolicyProxy)
 }
 
 // getAllPolicies retrieves all Genesys Cloud Recording Media Retention Policies
@@ -2,9 +2,15 @@ package resource_cache
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strings"
 	"sync"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	"terraform-provider-genesyscloud/genesyscloud/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -18,6 +24,46 @@ type DataSourceCache struct {
 	ClientConfig     *platformclientv2.Configuration
 	HydrateCacheFunc func(*DataSourceCache) error
 	getApiFunc       func(*DataSourceCache, string, context.Context) (string, diag.Diagnostics)
+
+	// RevalidatePath is the API path of the object an ID resolves to, with the ID as {id}, e.g. /api/v2/users/{id}. Expired entries
+	// of the on-disk cache are revalidated against it with their ETag. When it is empty, expired entries are looked up again.
+	RevalidatePath string
+
+	// RevalidateFields are the fields of the object that the data source looks up by, e.g. name. A revalidated entry of an object
+	// that changed is still valid when one of these fields matches its key.
+	RevalidateFields []string
+
+	// NormalizeKey returns the key of a name, e.g. a lower case queue name, when the data source doesn't look up names as they are.
+	// The revalidate fields of the object are normalized before they are compared to the key.
+	NormalizeKey func(string) string
+
+	persistentOnce sync.Once
+	persistent     *diskCache[string]
+}
+
+// dataSourceCaches holds the caches of the data sources of a provider instance by data source name
+type dataSourceCaches struct {
+	mutex  sync.Mutex
+	caches map[string]*DataSourceCache
+}
+
+func newDataSourceCaches(*platformclientv2.Configuration) *dataSourceCaches {
+	return &dataSourceCaches{caches: make(map[string]*DataSourceCache)}
+}
+
+// GetDataSourceCache returns the cache of a data source for the provider instance that owns the client config, creating it with
+// newCache on first use, so the data sources of provider instances configured for different orgs don't share their caches
+func GetDataSourceCache(clientConfig *platformclientv2.Configuration, resourceName string, newCache func(*platformclientv2.Configuration) *DataSourceCache) *DataSourceCache {
+	caches := provider.GetProxy(clientConfig, newDataSourceCaches)
+	caches.mutex.Lock()
+	defer caches.mutex.Unlock()
+
+	cache, ok := caches.caches[resourceName]
+	if !ok {
+		cache = newCache(clientConfig)
+		caches.caches[resourceName] = cache
+	}
+	return cache
 }
 
 // NewDataSourceCache creates a new data source cache
@@ -38,6 +84,9 @@ func (c *DataSourceCache) HydrateCacheIfEmpty() error {
 		if err := c.hydrateCache(); err != nil {
 			return err
 		}
+		if c.persistent != nil {
+			c.persistent.setEntries(c.Cache, "")
+		}
 	}
 	return nil
 }
@@ -56,6 +105,9 @@ func (c *DataSourceCache) UpdateCacheEntry(key string, val string) error {
 		return fmt.Errorf("cache is not initialized")
 	}
 	c.Cache[key] = val
+	if c.persistent != nil {
+		c.persistent.Set(key, val)
+	}
 
 	log.Printf("updated cache entry [%v] to value: %v", key, val)
 
@@ -88,8 +140,108 @@ func (c *DataSourceCache) Get(key string) (val string, isFound bool) {
 	return id, true
 }
 
+// attachPersistentCache loads the on-disk cache of the data source for the org when it is enabled in the provider. The cache
+// file is named after the data source, so data sources don't resolve each other's names.
+func (c *DataSourceCache) attachPersistentCache(resourceName string, settings *provider.DataSourceCacheSettings) {
+	c.persistentOnce.Do(func() {
+		if settings == nil {
+			return
+		}
+		path := filepath.Join(settings.Dir, settings.OrgId, resourceName+".json")
+		log.Printf("using on-disk cache %s for data source %s", path, resourceName)
+		c.persistent = newDiskCache[string](path, settings.TTL)
+	})
+}
+
+// getPersistent returns the ID of a key from the on-disk cache. Expired entries are revalidated with the API and dropped when the
+// object they resolve to has changed, so the key is looked up again.
+func (c *DataSourceCache) getPersistent(key string) (string, bool) {
+	if c.persistent == nil {
+		return "", false
+	}
+	entry, ok := c.persistent.entry(key)
+	if !ok {
+		return "", false
+	}
+	if c.persistent.isFresh(&entry) {
+		log.Printf("on-disk cache hit. found key %v with value %v", key, entry.Value)
+		return entry.Value, true
+	}
+	if c.RevalidatePath == "" {
+		return "", false
+	}
+
+	eTag, valid, err := c.revalidate(key, entry)
+	if err != nil {
+		log.Printf("failed to revalidate on-disk cache entry %v: %v", key, err)
+		return "", false
+	}
+	if !valid {
+		log.Printf("on-disk cache entry %v with value %v is no longer valid", key, entry.Value)
+		c.persistent.Delete(key)
+		return "", false
+	}
+	log.Printf("revalidated on-disk cache entry %v with value %v", key, entry.Value)
+	c.persistent.setEntries(map[string]string{key: entry.Value}, eTag)
+	return entry.Value, true
+}
+
+// revalidate requests the object of an expired entry with its ETag. The entry is still valid when the object is not modified, or
+// when one of the revalidate fields of the object, e.g. its name, still matches the key. It returns the current ETag of the object.
+func (c *DataSourceCache) revalidate(key string, entry diskCacheEntry[string]) (string, bool, error) {
+	path := c.ClientConfig.BasePath + strings.ReplaceAll(c.RevalidatePath, "{id}", url.PathEscape(entry.Value))
+	headerParams := map[string]string{"Accept": "application/json"}
+	if c.ClientConfig.AccessToken != "" {
+		headerParams["Authorization"] = "Bearer " + c.ClientConfig.AccessToken
+	}
+	if entry.ETag != "" {
+		headerParams["If-None-Match"] = entry.ETag
+	}
+
+	// The SDK reports every status outside of the 2xx range as an error, so the status is checked first
+	response, err := c.ClientConfig.APIClient.CallAPI(path, http.MethodGet, nil, headerParams, nil, nil, "", nil)
+	if response != nil {
+		switch response.StatusCode {
+		case http.StatusNotModified:
+			return entry.ETag, true, nil
+		case http.StatusNotFound:
+			return "", false, nil
+		}
+	}
+	if err != nil {
+		return "", false, err
+	}
+	eTag := http.Header(response.Header).Get("ETag")
+
+	var object map[string]interface{}
+	if err := json.Unmarshal(response.RawBody, &object); err != nil {
+		return "", false, err
+	}
+	for _, field := range c.RevalidateFields {
+		if value, ok := object[field].(string); ok && c.normalizeKey(value) == key {
+			return eTag, true, nil
+		}
+	}
+	return "", false, nil
+}
+
+// normalizeKey returns the key of a name with the NormalizeKey function of the data source, if it has one
+func (c *DataSourceCache) normalizeKey(name string) string {
+	if c.NormalizeKey == nil {
+		return name
+	}
+	return c.NormalizeKey(name)
+}
+
+// RetrieveId returns the ID of a key from the cache of a data source, looking it up with the API on a cache miss. The on-disk cache
+// is used when the provider config enables it with settings.
 func RetrieveId(cache *DataSourceCache,
-	resourceName, key string, ctx context.Context) (string, diag.Diagnostics) {
+	resourceName, key string, settings *provider.DataSourceCacheSettings, ctx context.Context) (string, diag.Diagnostics) {
+
+	cache.attachPersistentCache(resourceName, settings)
+	if id, ok := cache.getPersistent(key); ok {
+		return id, nil
+	}
 
 	if err := cache.HydrateCacheIfEmpty(); err != nil {
 		return "", diag.FromErr(err)
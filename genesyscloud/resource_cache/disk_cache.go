@@ -0,0 +1,186 @@
+package resource_cache
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+/*
+diskCache is a CacheInterface that keeps its values in a JSON file, so they can be shared by provider processes and reused across
+runs. Every entry expires after the TTL of the cache and can carry the ETag of the object it was resolved to, so an expired entry can
+be revalidated instead of being looked up again. Get and GetAll only return entries that have not expired.
+
+Every change is written to the file straight away, as provider processes are stopped without notice. Other processes may write the
+same file at the same time, so the entries they wrote since the file was loaded are merged in before it is replaced.
+*/
+
+type diskCacheEntry[T any] struct {
+	Value   T         `json:"value"`
+	ETag    string    `json:"etag,omitempty"`
+	Expires time.Time `json:"expires"`
+}
+
+type diskCache[T any] struct {
+	path    string
+	ttl     time.Duration
+	lock    sync.Mutex
+	data    map[string]*diskCacheEntry[T]
+	deleted map[string]bool
+	now     func() time.Time
+}
+
+// NewDiskCache returns a cache whose values are kept in the file at path and expire after the TTL
+func NewDiskCache[T any](path string, ttl time.Duration) CacheInterface[T] {
+	return newDiskCache[T](path, ttl)
+}
+
+func newDiskCache[T any](path string, ttl time.Duration) *diskCache[T] {
+	c := &diskCache[T]{
+		path:    path,
+		ttl:     ttl,
+		deleted: make(map[string]bool),
+		now:     time.Now,
+	}
+	c.data = c.load()
+	return c
+}
+
+// load reads the entries of the cache file. A missing or unreadable file is treated as an empty cache.
+func (c *diskCache[T]) load() map[string]*diskCacheEntry[T] {
+	data := make(map[string]*diskCacheEntry[T])
+	content, err := os.ReadFile(c.path)
+	if err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			log.Printf("failed to read cache file %s: %v", c.path, err)
+		}
+		return data
+	}
+	if err := json.Unmarshal(content, &data); err != nil {
+		log.Printf("ignoring cache file %s that could not be parsed: %v", c.path, err)
+		return make(map[string]*diskCacheEntry[T])
+	}
+	return data
+}
+
+// save merges the entries written by other processes and replaces the cache file. The lock must be held by the caller.
+func (c *diskCache[T]) save() {
+	for key, entry := range c.load() {
+		if c.deleted[key] {
+			continue
+		}
+		if current, ok := c.data[key]; !ok || entry.Expires.After(current.Expires) {
+			c.data[key] = entry
+		}
+	}
+
+	content, err := json.MarshalIndent(c.data, "", "  ")
+	if err != nil {
+		log.Printf("failed to encode cache file %s: %v", c.path, err)
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), os.ModePerm); err != nil {
+		log.Printf("failed to create directory of cache file %s: %v", c.path, err)
+		return
+	}
+	// Write a temporary file first, so other processes never read a partially written cache
+	tempFile, err := os.CreateTemp(filepath.Dir(c.path), filepath.Base(c.path)+".*.tmp")
+	if err != nil {
+		log.Printf("failed to write cache file %s: %v", c.path, err)
+		return
+	}
+	_, err = tempFile.Write(content)
+	if closeErr := tempFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tempFile.Name(), c.path)
+	}
+	if err != nil {
+		_ = os.Remove(tempFile.Name())
+		log.Printf("failed to write cache file %s: %v", c.path, err)
+	}
+}
+
+func (c *diskCache[T]) isFresh(entry *diskCacheEntry[T]) bool {
+	return c.now().Before(entry.Expires)
+}
+
+// Set stores a value in the cache file
+func (c *diskCache[T]) Set(key string, value T) {
+	c.setEntries(map[string]T{key: value}, "")
+}
+
+// setEntries stores values, along with the ETag of the object they were resolved to, in a single write of the cache file
+func (c *diskCache[T]) setEntries(values map[string]T, eTag string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	expires := c.now().Add(c.ttl)
+	for key, value := range values {
+		c.data[key] = &diskCacheEntry[T]{Value: value, ETag: eTag, Expires: expires}
+		delete(c.deleted, key)
+	}
+	c.save()
+}
+
+func (c *diskCache[T]) Delete(key string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.data, key)
+	c.deleted[key] = true
+	c.save()
+}
+
+// Get retrieves a value from the cache if it has not expired
+func (c *diskCache[T]) Get(key string) (T, bool) {
+	entry, ok := c.entry(key)
+	if !ok || !c.isFresh(&entry) {
+		var empty T
+		return empty, false
+	}
+	return entry.Value, true
+}
+
+// entry retrieves an entry from the cache whether it has expired or not
+func (c *diskCache[T]) entry(key string) (diskCacheEntry[T], bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	entry, ok := c.data[key]
+	if !ok {
+		return diskCacheEntry[T]{}, false
+	}
+	return *entry, true
+}
+
+// GetAll retrieves the values of the cache that have not expired
+func (c *diskCache[T]) GetAll() []T {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	var items []T
+	for _, entry := range c.data {
+		if c.isFresh(entry) {
+			items = append(items, entry.Value)
+		}
+	}
+	return items
+}
+
+// GetSize retrieves the number of values of the cache that have not expired
+func (c *diskCache[T]) GetSize() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	size := 0
+	for _, entry := range c.data {
+		if c.isFresh(entry) {
+			size++
+		}
+	}
+	return size
+}
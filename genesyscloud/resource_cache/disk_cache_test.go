@@ -0,0 +1,137 @@
+package resource_cache
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestUnitDiskCacheTTL verifies values are shared through the cache file and expire after the TTL
+func TestUnitDiskCacheTTL(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "org", "cache.json")
+	now := time.Now()
+
+	writer := newDiskCache[string](path, time.Minute)
+	writer.now = func() time.Time { return now }
+	writer.Set("queue", "1")
+	writer.Set("skill", "2")
+	writer.Delete("skill")
+
+	reader := newDiskCache[string](path, time.Minute)
+	reader.now = func() time.Time { return now }
+	value, ok := reader.Get("queue")
+	assert.True(t, ok)
+	assert.Equal(t, "1", value)
+	_, ok = reader.Get("skill")
+	assert.False(t, ok)
+	assert.Equal(t, 1, reader.GetSize())
+
+	// Entries written by another process are kept when the file is replaced
+	writer.Set("user", "3")
+	reader.Set("flow", "4")
+	assert.Equal(t, 3, newDiskCache[string](path, time.Minute).GetSize())
+
+	reader.now = func() time.Time { return now.Add(2 * time.Minute) }
+	_, ok = reader.Get("queue")
+	assert.False(t, ok)
+	assert.Empty(t, reader.GetAll())
+}
+
+// TestUnitDataSourceCacheRevalidation verifies data sources reuse the on-disk cache of the org and revalidate expired entries
+func TestUnitDataSourceCacheRevalidation(t *testing.T) {
+	requests := make(map[string]int)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+		switch r.URL.Path {
+		case "/api/v2/routing/queues/1":
+			if r.Header.Get("If-None-Match") == `"v1"` {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			w.Header().Set("ETag", `"v1"`)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"id": "1", "name": "Support"}`))
+		case "/api/v2/routing/queues/3":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"id": "3", "name": "Billing", "description": "Support"}`))
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"status": 404, "code": "not.found", "message": "not found"}`))
+		}
+	}))
+	defer server.Close()
+
+	settings := &provider.DataSourceCacheSettings{Dir: t.TempDir(), TTL: time.Minute, OrgId: "org"}
+
+	config := platformclientv2.NewConfiguration()
+	config.BasePath = server.URL
+	hydrations := 0
+	newCache := func() *DataSourceCache {
+		cache := NewDataSourceCache(config, func(c *DataSourceCache) error {
+			hydrations++
+			c.Cache["Support"] = "1"
+			c.Cache["Sales"] = "2"
+			return nil
+		}, func(*DataSourceCache, string, context.Context) (string, diag.Diagnostics) {
+			return "", diag.Errorf("not found")
+		})
+		cache.RevalidatePath = "/api/v2/routing/queues/{id}"
+		cache.RevalidateFields = []string{"name"}
+		return cache
+	}
+
+	id, diagErr := RetrieveId(newCache(), "genesyscloud_routing_queue", "Support", settings, context.Background())
+	assert.Nil(t, diagErr)
+	assert.Equal(t, "1", id)
+
+	// Another process finds the hydrated entries on disk
+	cache := newCache()
+	id, diagErr = RetrieveId(cache, "genesyscloud_routing_queue", "Sales", settings, context.Background())
+	assert.Nil(t, diagErr)
+	assert.Equal(t, "2", id)
+	assert.Equal(t, 1, hydrations)
+
+	expired := func() time.Time { return time.Now().Add(2 * time.Minute) }
+	cache.persistent.now = expired
+	id, _ = cache.getPersistent("Support")
+	assert.Equal(t, "1", id)
+	entry, _ := cache.persistent.entry("Support")
+	assert.Equal(t, `"v1"`, entry.ETag)
+
+	cache.persistent.now = func() time.Time { return expired().Add(2 * time.Minute) }
+	id, _ = cache.getPersistent("Support")
+	assert.Equal(t, "1", id)
+	assert.Equal(t, 2, requests["/api/v2/routing/queues/1"])
+
+	// Entries of objects that no longer exist are dropped
+	_, ok := cache.getPersistent("Sales")
+	assert.False(t, ok)
+	_, ok = cache.persistent.entry("Sales")
+	assert.False(t, ok)
+
+	// Keys normalized by the data source are compared to the normalized fields of the object
+	normalized := newCache()
+	normalized.NormalizeKey = strings.ToLower
+	normalized.attachPersistentCache("genesyscloud_routing_queue", settings)
+	normalized.persistent.setEntries(map[string]string{"support": "1"}, "")
+	normalized.persistent.now = expired
+	id, ok = normalized.getPersistent("support")
+	assert.True(t, ok)
+	assert.Equal(t, "1", id)
+
+	// Only the fields the data source looks up by are compared, so another object whose description matches the key is not valid
+	normalized.persistent.setEntries(map[string]string{"support": "3"}, "")
+	normalized.persistent.now = func() time.Time { return expired().Add(2 * time.Minute) }
+	_, ok = normalized.getPersistent("support")
+	assert.False(t, ok)
+}
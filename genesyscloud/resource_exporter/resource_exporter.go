@@ -5,6 +5,7 @@ import (
 	"regexp"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -23,8 +24,19 @@ type ResourceMeta struct {
 	// Name of the resource to be used in exports
 	Name string
 
+	// Name of the object in Genesys Cloud before it was sanitized. Set by LoadSanitizedResourceMap.
+	OriginalName string
+
 	// Prefix to add to the ID when reading state
 	IdPrefix string
+
+	// Version or modified date of the object as returned when listing it. Incremental exports
+	// skip re-reading objects whose version matches the one recorded by the previous export.
+	Version string
+
+	// Date the object was last modified as returned when listing it. Used by the modified_after
+	// export filter. Nil when the API does not report a modified date.
+	DateModified *time.Time
 }
 
 // ResourceIDMetaMap is a map of IDs to ResourceMeta
@@ -35,6 +47,10 @@ type GetAllCustomResourcesFunc func(context.Context) (ResourceIDMetaMap, *Depend
 // GetAllResourcesFunc is a method that returns all resource IDs
 type GetAllResourcesFunc func(context.Context) (ResourceIDMetaMap, diag.Diagnostics)
 
+// LookupReferenceFunc is a method that looks up an object referenced by another resource. It returns the kind of the object,
+// e.g. the type of a flow, and false when the object does not exist.
+type LookupReferenceFunc func(ctx context.Context, id string) (string, bool, diag.Diagnostics)
+
 // RefAttrSettings contains behavior settings for references
 type RefAttrSettings struct {
 
@@ -43,6 +59,9 @@ type RefAttrSettings struct {
 
 	// Values that may be set that should not be treated as IDs
 	AltValues []string
+
+	// Kinds of the referenced object that may be referenced, e.g. the types of flows. Objects of any kind may be referenced when empty
+	RefKinds []string
 }
 
 type ResourceInfo struct {
@@ -97,6 +116,10 @@ type ResourceExporter struct {
 	// Names will be sanitized with part of the ID appended, so it is not required that they be unique
 	GetResourcesFunc GetAllResourcesFunc
 
+	// Method to look up a single resource by ID when it is referenced by another resource. It is used to validate references
+	// during plan when the validate_references provider setting is enabled. References to resources without it are not validated.
+	LookupReferenceFunc LookupReferenceFunc
+
 	// A map of resource attributes to types that they reference
 	// Attributes in nested objects can be defined with a '.' separator
 	RefAttrs map[string]*RefAttrSettings
@@ -165,6 +188,10 @@ func (r *ResourceExporter) LoadSanitizedResourceMap(ctx context.Context, name st
 	r.SanitizedResourceMap = result
 	r.mutex.Unlock()
 
+	for _, meta := range r.SanitizedResourceMap {
+		meta.OriginalName = meta.Name
+	}
+
 	sanitizer := NewSanitizerProvider()
 	sanitizer.S.Sanitize(r.SanitizedResourceMap)
 
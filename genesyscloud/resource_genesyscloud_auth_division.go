@@ -43,10 +43,24 @@ func getAllAuthDivisions(_ context.Context, clientConfig *platformclientv2.Confi
 	return resources, nil
 }
 
+// lookupAuthDivisionReference looks up a division referenced by another resource
+func lookupAuthDivisionReference(_ context.Context, clientConfig *platformclientv2.Configuration, id string) (string, bool, diag.Diagnostics) {
+	authAPI := platformclientv2.NewAuthorizationApiWithConfig(clientConfig)
+	_, resp, err := authAPI.GetAuthorizationDivision(id, false)
+	if err != nil {
+		if util.IsStatus404(resp) {
+			return "", false, nil
+		}
+		return "", false, util.BuildAPIDiagnosticError("genesyscloud_auth_division", fmt.Sprintf("Failed to get division %s error: %s", id, err), resp)
+	}
+	return "", true, nil
+}
+
 func AuthDivisionExporter() *resourceExporter.ResourceExporter {
 	return &resourceExporter.ResourceExporter{
-		GetResourcesFunc: provider.GetAllWithPooledClient(getAllAuthDivisions),
-		RefAttrs:         map[string]*resourceExporter.RefAttrSettings{}, // No references
+		GetResourcesFunc:    provider.GetAllWithPooledClient(getAllAuthDivisions),
+		LookupReferenceFunc: provider.LookupReferenceWithPooledClient(lookupAuthDivisionReference),
+		RefAttrs:            map[string]*resourceExporter.RefAttrSettings{}, // No references
 	}
 }
 
@@ -186,7 +200,7 @@ func deleteAuthDivision(ctx context.Context, d *schema.ResourceData, meta interf
 
 	// Sometimes a division with resources in it priorly still thinks it is attached to those resources during a destroy run.
 	// We're retrying again as those resources should detach completely eventually.
-	diagErr := util.RetryWhen(util.IsStatus400, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+	diagErr := util.RetryWhen(ctx, util.IsStatus400, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 		log.Printf("Deleting division %s", name)
 		resp, err := authAPI.DeleteAuthorizationDivision(d.Id(), false)
 		if err != nil {
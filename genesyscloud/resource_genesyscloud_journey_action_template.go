@@ -370,7 +370,7 @@ func updateJourneyActionTemplate(ctx context.Context, data *schema.ResourceData,
 	journeyApi := journeyApiConfig(i)
 	patchActionTemplate := buildSdkPatchActionTemplate(data)
 	log.Printf("Updating Journey Action Template %s", data.Id())
-	diagErr := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+	diagErr := util.RetryWhen(ctx, util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 		actionTemplate, resp, getErr := journeyApi.GetJourneyActiontemplate(data.Id())
 		if getErr != nil {
 			return resp, util.BuildAPIDiagnosticError("genesyscloud_journey_action_template", fmt.Sprintf("failed to read journey action template %s error: %s", data.Id(), getErr), resp)
@@ -185,7 +185,7 @@ func updateJourneyOutcome(ctx context.Context, d *schema.ResourceData, meta inte
 	patchOutcome := buildSdkPatchOutcome(d)
 
 	log.Printf("Updating journey outcome %s", d.Id())
-	diagErr := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+	diagErr := util.RetryWhen(ctx, util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 		// Get current journey outcome version
 		journeyOutcome, resp, getErr := journeyApi.GetJourneyOutcome(d.Id())
 		if getErr != nil {
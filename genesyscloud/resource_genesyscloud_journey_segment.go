@@ -312,7 +312,7 @@ func updateJourneySegment(ctx context.Context, d *schema.ResourceData, meta inte
 	patchSegment := buildSdkPatchSegment(d)
 
 	log.Printf("Updating journey segment %s", d.Id())
-	diagErr := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+	diagErr := util.RetryWhen(ctx, util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 		// Get current journey segment version
 		journeySegment, resp, getErr := journeyApi.GetJourneySegment(d.Id())
 		if getErr != nil {
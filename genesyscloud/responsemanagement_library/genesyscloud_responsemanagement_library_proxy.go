@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
 )
@@ -52,13 +53,13 @@ func newResponsemanagementLibraryProxy(clientConfig *platformclientv2.Configurat
 	}
 }
 
-// getResponsemanagementLibraryProxy acts as a singleton to for the internalProxy.  It also ensures
+// getResponsemanagementLibraryProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getResponsemanagementLibraryProxy(clientConfig *platformclientv2.Configuration) *responsemanagementLibraryProxy {
-	if internalProxy == nil {
-		internalProxy = newResponsemanagementLibraryProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newResponsemanagementLibraryProxy)
 }
 
 // createResponsemanagementLibrary creates a Genesys Cloud responsemanagement library
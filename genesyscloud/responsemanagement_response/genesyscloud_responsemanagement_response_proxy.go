@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
 )
@@ -52,13 +53,13 @@ func newResponsemanagementResponseProxy(clientConfig *platformclientv2.Configura
 	}
 }
 
-// getResponsemanagementResponseProxy acts as a singleton to for the internalProxy.  It also ensures
+// getResponsemanagementResponseProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getResponsemanagementResponseProxy(clientConfig *platformclientv2.Configuration) *responsemanagementResponseProxy {
-	if internalProxy == nil {
-		internalProxy = newResponsemanagementResponseProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newResponsemanagementResponseProxy)
 }
 
 // createResponsemanagementResponse creates a Genesys Cloud responsemanagement response
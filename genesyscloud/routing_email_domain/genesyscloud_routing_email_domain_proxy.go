@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
@@ -48,13 +49,13 @@ func newRoutingEmailDomainProxy(clientConfig *platformclientv2.Configuration) *r
 	}
 }
 
-// getRoutingEmailDomainProxy acts as a singleton to for the internalProxy.  It also ensures
+// getRoutingEmailDomainProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getRoutingEmailDomainProxy(clientConfig *platformclientv2.Configuration) *routingEmailDomainProxy {
-	if internalProxy == nil {
-		internalProxy = newRoutingEmailDomainProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newRoutingEmailDomainProxy)
 }
 
 func (p *routingEmailDomainProxy) getAllRoutingEmailDomains(ctx context.Context) (*[]platformclientv2.Inbounddomain, *platformclientv2.APIResponse, error) {
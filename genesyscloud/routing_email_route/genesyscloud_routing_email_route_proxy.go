@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
 )
@@ -52,13 +53,13 @@ func newRoutingEmailRouteProxy(clientConfig *platformclientv2.Configuration) *ro
 	}
 }
 
-// getRoutingEmailRouteProxy acts as a singleton to for the internalProxy.  It also ensures
+// getRoutingEmailRouteProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getRoutingEmailRouteProxy(clientConfig *platformclientv2.Configuration) *routingEmailRouteProxy {
-	if internalProxy == nil {
-		internalProxy = newRoutingEmailRouteProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newRoutingEmailRouteProxy)
 }
 
 // createRoutingEmailRoute creates a Genesys Cloud routing email route
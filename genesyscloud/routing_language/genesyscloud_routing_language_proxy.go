@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
@@ -46,10 +47,10 @@ func newRoutingLanguageProxy(clientConfig *platformclientv2.Configuration) *rout
 }
 
 func getRoutingLanguageProxy(clientConfig *platformclientv2.Configuration) *routingLanguageProxy {
-	if internalProxy == nil {
-		internalProxy = newRoutingLanguageProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newRoutingLanguageProxy)
 }
 
 // getRoutingLanguage retrieves all Genesys Cloud routing language
@@ -18,21 +18,14 @@ import (
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
 )
 
-var (
-	dataSourceRoutingQueueCache *rc.DataSourceCache
-)
-
 func dataSourceRoutingQueueRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sdkConfig := m.(*provider.ProviderMeta).ClientConfig
 
 	key := d.Get("name").(string)
 	key = normalizeQueueName(key)
 
-	if dataSourceRoutingQueueCache == nil {
-		dataSourceRoutingQueueCache = rc.NewDataSourceCache(sdkConfig, hydrateRoutingQueueCacheFn, getQueueByNameFn)
-	}
-
-	queueId, err := rc.RetrieveId(dataSourceRoutingQueueCache, resourceName, key, ctx)
+	cache := rc.GetDataSourceCache(sdkConfig, resourceName, newRoutingQueueDataSourceCache)
+	queueId, err := rc.RetrieveId(cache, resourceName, key, m.(*provider.ProviderMeta).DataSourceCache, ctx)
 
 	if err != nil {
 		return err
@@ -110,3 +103,11 @@ func getQueueByNameFn(c *rc.DataSourceCache, name string, ctx context.Context) (
 
 	return queueId, diag
 }
+
+func newRoutingQueueDataSourceCache(sdkConfig *platformclientv2.Configuration) *rc.DataSourceCache {
+	cache := rc.NewDataSourceCache(sdkConfig, hydrateRoutingQueueCacheFn, getQueueByNameFn)
+	cache.RevalidatePath = "/api/v2/routing/queues/{id}"
+	cache.RevalidateFields = []string{"name"}
+	cache.NormalizeKey = normalizeQueueName
+	return cache
+}
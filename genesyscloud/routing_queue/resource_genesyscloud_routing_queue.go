@@ -43,12 +43,25 @@ func getAllRoutingQueues(ctx context.Context, clientConfig *platformclientv2.Con
 	}
 
 	for _, queue := range *queues {
-		resources[*queue.Id] = &resourceExporter.ResourceMeta{Name: *queue.Name}
+		// The modified date of a queue doesn't change with its members or wrapup codes, so queues are read on every incremental export
+		resources[*queue.Id] = &resourceExporter.ResourceMeta{Name: *queue.Name, DateModified: queue.DateModified}
 	}
 
 	return resources, nil
 }
 
+// lookupRoutingQueueReference looks up a routing queue referenced by another resource
+func lookupRoutingQueueReference(ctx context.Context, clientConfig *platformclientv2.Configuration, id string) (string, bool, diag.Diagnostics) {
+	_, resp, err := GetRoutingQueueProxy(clientConfig).getRoutingQueueById(ctx, id)
+	if err != nil {
+		if util.IsStatus404(resp) {
+			return "", false, nil
+		}
+		return "", false, util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to get queue %s | error: %s", id, err), resp)
+	}
+	return "", true, nil
+}
+
 func createQueue(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
 	routingAPI := platformclientv2.NewRoutingApiWithConfig(sdkConfig)
@@ -510,12 +510,13 @@ func ResourceRoutingQueue() *schema.Resource {
 
 func RoutingQueueExporter() *resourceExporter.ResourceExporter {
 	return &resourceExporter.ResourceExporter{
-		GetResourcesFunc: provider.GetAllWithPooledClient(getAllRoutingQueues),
+		GetResourcesFunc:    provider.GetAllWithPooledClient(getAllRoutingQueues),
+		LookupReferenceFunc: provider.LookupReferenceWithPooledClient(lookupRoutingQueueReference),
 		RefAttrs: map[string]*resourceExporter.RefAttrSettings{
 			"division_id":                              {RefType: "genesyscloud_auth_division"},
-			"queue_flow_id":                            {RefType: "genesyscloud_flow"},
-			"email_in_queue_flow_id":                   {RefType: "genesyscloud_flow"},
-			"message_in_queue_flow_id":                 {RefType: "genesyscloud_flow"},
+			"queue_flow_id":                            {RefType: "genesyscloud_flow", RefKinds: []string{"INQUEUECALL"}},
+			"email_in_queue_flow_id":                   {RefType: "genesyscloud_flow", RefKinds: []string{"INQUEUEEMAIL"}},
+			"message_in_queue_flow_id":                 {RefType: "genesyscloud_flow", RefKinds: []string{"INQUEUESHORTMESSAGE"}},
 			"whisper_prompt_id":                        {RefType: "genesyscloud_architect_user_prompt"},
 			"on_hold_prompt_id":                        {RefType: "genesyscloud_architect_user_prompt"},
 			"outbound_messaging_sms_address_id":        {},                               // Ref type not yet defined
@@ -3,6 +3,7 @@ package routing_queue_conditional_group_routing
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 	routingQueue "terraform-provider-genesyscloud/genesyscloud/routing_queue"
 
@@ -44,11 +45,10 @@ func newRoutingQueueConditionalGroupRoutingProxy(clientConfig *platformclientv2.
 
 // getRoutingQueueConditionalGroupRoutingProxy retrieves all Genesys Cloud Routing queue conditional group routing
 func getRoutingQueueConditionalGroupRoutingProxy(clientConfig *platformclientv2.Configuration) *routingQueueConditionalGroupRoutingProxy {
-	if internalProxy == nil {
-		internalProxy = newRoutingQueueConditionalGroupRoutingProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return provider.GetProxy(clientConfig, newRoutingQueueConditionalGroupRoutingProxy)
 }
 
 // getRoutingQueueById get a queue by ID
@@ -3,6 +3,7 @@ package routing_queue_outbound_email_address
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 	routingQueue "terraform-provider-genesyscloud/genesyscloud/routing_queue"
 
@@ -39,11 +40,10 @@ func newRoutingQueueOutboundEmailAddressProxy(clientConfig *platformclientv2.Con
 }
 
 func getRoutingQueueOutboundEmailAddressProxy(clientConfig *platformclientv2.Configuration) *routingQueueOutboundEmailAddressProxy {
-	if internalProxy == nil {
-		internalProxy = newRoutingQueueOutboundEmailAddressProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return provider.GetProxy(clientConfig, newRoutingQueueOutboundEmailAddressProxy)
 }
 
 // getRoutingQueueOutboundEmailAddress gets the Outbound Email Address for a queue
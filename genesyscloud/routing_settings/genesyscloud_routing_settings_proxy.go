@@ -2,6 +2,7 @@ package routing_settings
 
 import (
 	"context"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
 )
@@ -48,10 +49,10 @@ func newRoutingSettingsProxy(clientConfig *platformclientv2.Configuration) *rout
 }
 
 func getRoutingSettingsProxy(clientConfig *platformclientv2.Configuration) *routingSettingsProxy {
-	if internalProxy == nil {
-		internalProxy = newRoutingSettingsProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newRoutingSettingsProxy)
 }
 
 func (p *routingSettingsProxy) getRoutingSettings(ctx context.Context) (*platformclientv2.Routingsettings, *platformclientv2.APIResponse, error) {
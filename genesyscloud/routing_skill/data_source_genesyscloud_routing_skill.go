@@ -13,19 +13,15 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
 )
 
-var dataSourceRoutingSkillCache *rc.DataSourceCache
-
 func dataSourceRoutingSkillRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sdkConfig := m.(*provider.ProviderMeta).ClientConfig
 	key := d.Get("name").(string)
 
-	if dataSourceRoutingSkillCache == nil {
-		dataSourceRoutingSkillCache = rc.NewDataSourceCache(sdkConfig, hydrateRoutingSkillCacheFn, getSkillByNameFn)
-	}
-
-	queueId, err := rc.RetrieveId(dataSourceRoutingSkillCache, resourceName, key, ctx)
+	cache := rc.GetDataSourceCache(sdkConfig, resourceName, newRoutingSkillDataSourceCache)
+	queueId, err := rc.RetrieveId(cache, resourceName, key, m.(*provider.ProviderMeta).DataSourceCache, ctx)
 	if err != nil {
 		return err
 	}
@@ -76,3 +72,10 @@ func getSkillByNameFn(c *rc.DataSourceCache, name string, ctx context.Context) (
 	})
 	return skillId, diag
 }
+
+func newRoutingSkillDataSourceCache(sdkConfig *platformclientv2.Configuration) *rc.DataSourceCache {
+	cache := rc.NewDataSourceCache(sdkConfig, hydrateRoutingSkillCacheFn, getSkillByNameFn)
+	cache.RevalidatePath = "/api/v2/routing/skills/{id}"
+	cache.RevalidateFields = []string{"name"}
+	return cache
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
@@ -46,11 +47,10 @@ func newRoutingSkillProxy(clientConfig *platformclientv2.Configuration) *routing
 }
 
 func getRoutingSkillProxy(clientConfig *platformclientv2.Configuration) *routingSkillProxy {
-	if internalProxy == nil {
-		internalProxy = newRoutingSkillProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return provider.GetProxy(clientConfig, newRoutingSkillProxy)
 }
 
 func (p *routingSkillProxy) getAllRoutingSkills(ctx context.Context, name string) (*[]platformclientv2.Routingskill, *platformclientv2.APIResponse, error) {
@@ -32,12 +32,28 @@ func getAllRoutingSkills(ctx context.Context, clientConfig *platformclientv2.Con
 	for _, skill := range *skills {
 		if skill.State != nil && *skill.State != "deleted" {
 			resources[*skill.Id] = &resourceExporter.ResourceMeta{Name: *skill.Name}
+			if skill.DateModified != nil {
+				resources[*skill.Id].Version = skill.DateModified.String()
+				resources[*skill.Id].DateModified = skill.DateModified
+			}
 		}
 	}
 
 	return resources, nil
 }
 
+// lookupRoutingSkillReference looks up a routing skill referenced by another resource
+func lookupRoutingSkillReference(ctx context.Context, clientConfig *platformclientv2.Configuration, id string) (string, bool, diag.Diagnostics) {
+	_, resp, err := getRoutingSkillProxy(clientConfig).getRoutingSkillById(ctx, id)
+	if err != nil {
+		if util.IsStatus404(resp) {
+			return "", false, nil
+		}
+		return "", false, util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to get skill %s | error: %s", id, err), resp)
+	}
+	return "", true, nil
+}
+
 func createRoutingSkill(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
 	proxy := getRoutingSkillProxy(sdkConfig)
@@ -56,7 +56,8 @@ func ResourceRoutingSkill() *schema.Resource {
 
 func RoutingSkillExporter() *resourceExporter.ResourceExporter {
 	return &resourceExporter.ResourceExporter{
-		GetResourcesFunc: provider.GetAllWithPooledClient(getAllRoutingSkills),
-		RefAttrs:         map[string]*resourceExporter.RefAttrSettings{}, // No references
+		GetResourcesFunc:    provider.GetAllWithPooledClient(getAllRoutingSkills),
+		LookupReferenceFunc: provider.LookupReferenceWithPooledClient(lookupRoutingSkillReference),
+		RefAttrs:            map[string]*resourceExporter.RefAttrSettings{}, // No references
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	"terraform-provider-genesyscloud/genesyscloud/util"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
@@ -51,13 +52,13 @@ func newRoutingSkillGroupsProxy(clientConfig *platformclientv2.Configuration) *r
 	}
 }
 
-// getRoutingSkillGroupsProxy acts as a singleton to for the internalProxy.  It also ensures
+// getRoutingSkillGroupsProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getRoutingSkillGroupsProxy(clientConfig *platformclientv2.Configuration) *routingSkillGroupsProxy {
-	if internalProxy == nil {
-		internalProxy = newRoutingSkillGroupsProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newRoutingSkillGroupsProxy)
 }
 
 // getRoutingSkillGroups retrieves all Genesys Cloud routing skill groups
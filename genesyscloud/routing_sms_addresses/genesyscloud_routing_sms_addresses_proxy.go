@@ -3,6 +3,7 @@ package genesyscloud
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
 )
@@ -40,13 +41,13 @@ func newRoutingSmsAddressProxy(clientConfig *platformclientv2.Configuration) *ro
 	}
 }
 
-// getRoutingSmsAddressProxy acts as a singleton for the internalProxy.  It also ensures
+// getRoutingSmsAddressProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getRoutingSmsAddressProxy(clientConfig *platformclientv2.Configuration) *routingSmsAddressProxy {
-	if internalProxy == nil {
-		internalProxy = newRoutingSmsAddressProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newRoutingSmsAddressProxy)
 }
 
 // createSmsAddress creates a Genesys Cloud Sms Address
@@ -2,6 +2,7 @@ package routing_utilization
 
 import (
 	"context"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
 )
@@ -32,10 +33,10 @@ func newRoutingUtilizationProxy(clientConfig *platformclientv2.Configuration) *r
 }
 
 func getRoutingUtilizationProxy(clientConfig *platformclientv2.Configuration) *routingUtilizationProxy {
-	if internalProxy == nil {
-		internalProxy = newRoutingUtilizationProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newRoutingUtilizationProxy)
 }
 
 func (p *routingUtilizationProxy) getRoutingUtilization(ctx context.Context) (*platformclientv2.Utilizationresponse, *platformclientv2.APIResponse, error) {
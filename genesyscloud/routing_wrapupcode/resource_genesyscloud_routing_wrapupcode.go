@@ -31,6 +31,10 @@ func getAllRoutingWrapupCodes(ctx context.Context, clientConfig *platformclientv
 
 	for _, wrapupcode := range *wrapupcodes {
 		resources[*wrapupcode.Id] = &resourceExporter.ResourceMeta{Name: *wrapupcode.Name}
+		if wrapupcode.DateModified != nil {
+			resources[*wrapupcode.Id].Version = wrapupcode.DateModified.String()
+			resources[*wrapupcode.Id].DateModified = wrapupcode.DateModified
+		}
 	}
 
 	return resources, nil
@@ -3,6 +3,7 @@ package station
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
 )
@@ -30,13 +31,13 @@ func newStationProxy(clientConfig *platformclientv2.Configuration) *stationProxy
 	}
 }
 
-// getStationProxy acts as a singleton to for the internalProxy.  It also ensures
+// getStationProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getStationProxy(clientConfig *platformclientv2.Configuration) *stationProxy {
-	if internalProxy == nil {
-		internalProxy = newStationProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newStationProxy)
 }
 
 // getStationIdByName retrieves a Genesys Cloud Station ID by Name
@@ -3,6 +3,7 @@ package task_management_workbin
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 
@@ -56,13 +57,13 @@ func newTaskManagementWorkbinProxy(clientConfig *platformclientv2.Configuration)
 	}
 }
 
-// getTaskManagementWorkbinProxy acts as a singleton to for the internalProxy.  It also ensures
+// getTaskManagementWorkbinProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getTaskManagementWorkbinProxy(clientConfig *platformclientv2.Configuration) *taskManagementWorkbinProxy {
-	if internalProxy == nil {
-		internalProxy = newTaskManagementWorkbinProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newTaskManagementWorkbinProxy)
 }
 
 // createTaskManagementWorkbin creates a Genesys Cloud task management workbin
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 
@@ -57,13 +58,13 @@ func newTaskManagementWorkitemProxy(clientConfig *platformclientv2.Configuration
 	}
 }
 
-// getTaskManagementWorkitemProxy acts as a singleton to for the internalProxy.  It also ensures
+// getTaskManagementWorkitemProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getTaskManagementWorkitemProxy(clientConfig *platformclientv2.Configuration) *taskManagementWorkitemProxy {
-	if internalProxy == nil {
-		internalProxy = newTaskManagementWorkitemProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newTaskManagementWorkitemProxy)
 }
 
 // createTaskManagementWorkitem creates a Genesys Cloud task management workitem
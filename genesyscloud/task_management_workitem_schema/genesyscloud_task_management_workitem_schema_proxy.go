@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 
@@ -64,13 +65,13 @@ func newTaskManagementProxy(clientConfig *platformclientv2.Configuration) *taskM
 	}
 }
 
-// getTaskManagementProxy acts as a singleton to for the internalProxy.  It also ensures
+// getTaskManagementProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getTaskManagementProxy(clientConfig *platformclientv2.Configuration) *taskManagementProxy {
-	if internalProxy == nil {
-		internalProxy = newTaskManagementProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newTaskManagementProxy)
 }
 
 // createTaskManagementWorkitemSchema creates a Genesys Cloud task management workitem schema
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
@@ -59,13 +60,13 @@ func newTaskManagementWorktypeProxy(clientConfig *platformclientv2.Configuration
 	}
 }
 
-// GetTaskManagementWorktypeProxy acts as a singleton to for the internalProxy.  It also ensures
+// GetTaskManagementWorktypeProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func GetTaskManagementWorktypeProxy(clientConfig *platformclientv2.Configuration) *TaskManagementWorktypeProxy {
-	if internalProxy == nil {
-		internalProxy = newTaskManagementWorktypeProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newTaskManagementWorktypeProxy)
 }
 
 // createTaskManagementWorktype creates a Genesys Cloud task management worktype
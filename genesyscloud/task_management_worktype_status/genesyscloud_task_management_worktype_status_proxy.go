@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	taskManagementWorktype "terraform-provider-genesyscloud/genesyscloud/task_management_worktype"
 )
 
@@ -57,14 +58,13 @@ func newTaskManagementWorktypeStatusProxy(clientConfig *platformclientv2.Configu
 	}
 }
 
-// getTaskManagementWorktypeStatusProxy acts as a singleton to for the internalProxy.  It also ensures
+// getTaskManagementWorktypeStatusProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getTaskManagementWorktypeStatusProxy(clientConfig *platformclientv2.Configuration) *taskManagementWorktypeStatusProxy {
-	if internalProxy == nil {
-		internalProxy = newTaskManagementWorktypeStatusProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return provider.GetProxy(clientConfig, newTaskManagementWorktypeStatusProxy)
 }
 
 // createTaskManagementWorktypeStatus creates a Genesys Cloud task management worktype status
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	"terraform-provider-genesyscloud/genesyscloud/util"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
@@ -62,13 +63,13 @@ func newTeamProxy(clientConfig *platformclientv2.Configuration) *teamProxy {
 	}
 }
 
-// getTeamProxy acts as a singleton to for the internalProxy.  It also ensures
+// getTeamProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getTeamProxy(clientConfig *platformclientv2.Configuration) *teamProxy {
-	if internalProxy == nil {
-		internalProxy = newTeamProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newTeamProxy)
 }
 
 // createTeam creates a Genesys Cloud team
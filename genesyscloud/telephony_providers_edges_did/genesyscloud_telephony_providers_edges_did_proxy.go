@@ -3,6 +3,7 @@ package telephony_providers_edges_did
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	"terraform-provider-genesyscloud/genesyscloud/util"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
@@ -52,13 +53,13 @@ func newTelephonyProvidersEdgesDidProxy(clientConfig *platformclientv2.Configura
 	}
 }
 
-// getTelephonyProvidersEdgesDidProxy acts as a singleton for the internalProxy.  It also ensures
+// getTelephonyProvidersEdgesDidProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getTelephonyProvidersEdgesDidProxy(clientConfig *platformclientv2.Configuration) *telephonyProvidersEdgesDidProxy {
-	if internalProxy == nil {
-		internalProxy = newTelephonyProvidersEdgesDidProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newTelephonyProvidersEdgesDidProxy)
 }
 
 // getTelephonyProvidersEdgesDidIdByDid gets a Genesys Cloud telephony DID ID by DID number
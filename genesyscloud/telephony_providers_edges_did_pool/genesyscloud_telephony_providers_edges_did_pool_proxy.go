@@ -3,6 +3,7 @@ package telephony_providers_edges_did_pool
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	"terraform-provider-genesyscloud/genesyscloud/util"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
@@ -67,13 +68,13 @@ func newTelephonyProvidersEdgesDidPoolProxy(clientConfig *platformclientv2.Confi
 	}
 }
 
-// getTelephonyDidPoolProxy acts as a singleton for the internalProxy. It also ensures
+// getTelephonyDidPoolProxy returns the proxy of the provider instance that owns the client config. It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getTelephonyDidPoolProxy(clientConfig *platformclientv2.Configuration) *telephonyDidPoolProxy {
-	if internalProxy == nil {
-		internalProxy = newTelephonyProvidersEdgesDidPoolProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newTelephonyProvidersEdgesDidPoolProxy)
 }
 
 // createTelephonyDidPool creates a Genesys Cloud did pool
@@ -3,6 +3,7 @@ package telephony_providers_edges_did_pool
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
 )
@@ -19,7 +20,10 @@ type DidPoolStruct struct {
 // DeleteDidPoolWithStartAndEndNumber deletes a did pool by start and end number. Used as a cleanup function in tests which
 // utilise the did pool resource
 func DeleteDidPoolWithStartAndEndNumber(ctx context.Context, startNumber, endNumber string) (*platformclientv2.APIResponse, error) {
-	config := platformclientv2.GetDefaultConfiguration()
+	config, err := provider.AuthorizeSdk()
+	if err != nil {
+		return nil, err
+	}
 	proxy := getTelephonyDidPoolProxy(config)
 
 	didPoolId, _, _, err := proxy.getTelephonyDidPoolIdByStartAndEndNumber(ctx, startNumber, endNumber)
@@ -2,6 +2,7 @@ package telephony_providers_edges_extension_pool
 
 import (
 	"context"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
 )
@@ -42,10 +43,10 @@ func newExtensionPoolProxy(clientConfig *platformclientv2.Configuration) *extens
 }
 
 func getExtensionPoolProxy(clientConfig *platformclientv2.Configuration) *extensionPoolProxy {
-	if internalProxy == nil {
-		internalProxy = newExtensionPoolProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newExtensionPoolProxy)
 }
 
 func (p *extensionPoolProxy) getExtensionPool(ctx context.Context, extensionPoolId string) (*platformclientv2.Extensionpool, *platformclientv2.APIResponse, error) {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 
@@ -100,13 +101,13 @@ func newPhoneProxy(clientConfig *platformclientv2.Configuration) *phoneProxy {
 	}
 }
 
-// getPhoneProxy acts as a singleton to for the internalProxy.  It also ensures
+// getPhoneProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getPhoneProxy(clientConfig *platformclientv2.Configuration) *phoneProxy {
-	if internalProxy == nil {
-		internalProxy = newPhoneProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newPhoneProxy)
 }
 
 // getAllPhones retrieves all Genesys Cloud Phones
@@ -2,6 +2,7 @@ package telephony_providers_edges_phonebasesettings
 
 import (
 	"context"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
 )
@@ -42,13 +43,13 @@ func newphoneBaseProxy(clientConfig *platformclientv2.Configuration) *phoneBaseP
 	}
 }
 
-// getPhoneBaseProxy acts as a singleton to for the internalProxy.  It also ensures
+// getPhoneBaseProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getPhoneBaseProxy(clientConfig *platformclientv2.Configuration) *phoneBaseProxy {
-	if internalProxy == nil {
-		internalProxy = newphoneBaseProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newphoneBaseProxy)
 }
 
 func (p *phoneBaseProxy) getPhoneBaseSetting(ctx context.Context, phoneBaseSettingsId string) (*platformclientv2.Phonebase, *platformclientv2.APIResponse, error) {
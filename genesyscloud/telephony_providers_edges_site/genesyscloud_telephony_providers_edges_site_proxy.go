@@ -3,6 +3,7 @@ package telephony_providers_edges_site
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
@@ -127,13 +128,13 @@ func newSiteProxy(clientConfig *platformclientv2.Configuration) *SiteProxy {
 	}
 }
 
-// GetSiteProxy acts as a singleton for the internalProxy.  It also ensures
+// GetSiteProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func GetSiteProxy(clientConfig *platformclientv2.Configuration) *SiteProxy {
-	if internalProxy == nil {
-		internalProxy = newSiteProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newSiteProxy)
 }
 
 // GetAllSites retrieves all managed Genesys Cloud Sites
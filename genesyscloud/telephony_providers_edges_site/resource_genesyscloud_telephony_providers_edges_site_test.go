@@ -751,7 +751,10 @@ func generateSiteOutboundRoutesWithCustomAttrs(
 
 // getOrganizationDefaultSite is a test utiliy function to set the default site of the org
 func setDefaultSite(siteId string) error {
-	sdkConfig := platformclientv2.GetDefaultConfiguration()
+	sdkConfig, err := provider.AuthorizeSdk()
+	if err != nil {
+		return err
+	}
 	organizationApi := platformclientv2.NewOrganizationApiWithConfig(sdkConfig)
 
 	org, _, err := organizationApi.GetOrganizationsMe()
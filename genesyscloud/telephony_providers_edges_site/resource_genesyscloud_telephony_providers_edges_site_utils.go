@@ -229,7 +229,7 @@ func updateSiteNumberPlans(ctx context.Context, sp *SiteProxy, d *schema.Resourc
 		}
 	}
 
-	diagErr := util.RetryWhen(util.IsStatus400, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+	diagErr := util.RetryWhen(ctx, util.IsStatus400, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 		log.Printf("Updating number plans for site %s", d.Id())
 
 		_, resp, err := sp.updateSiteNumberPlans(ctx, d.Id(), &updatedNumberPlans)
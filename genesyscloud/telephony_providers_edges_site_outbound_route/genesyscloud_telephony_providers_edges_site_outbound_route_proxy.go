@@ -2,6 +2,7 @@ package telephony_providers_edges_site_outbound_route
 
 import (
 	"context"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 	telephonyProvidersEdgesSite "terraform-provider-genesyscloud/genesyscloud/telephony_providers_edges_site"
 
@@ -58,13 +59,13 @@ func newSiteOutboundRoutesProxy(clientConfig *platformclientv2.Configuration) *s
 	}
 }
 
-// getSiteOutboundRouteProxy acts as a singleton for the internalProxy.  It also ensures
+// getSiteOutboundRouteProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getSiteOutboundRouteProxy(clientConfig *platformclientv2.Configuration) *siteOutboundRoutesProxy {
-	if internalProxy == nil {
-		internalProxy = newSiteOutboundRoutesProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newSiteOutboundRoutesProxy)
 }
 
 func (p *siteOutboundRoutesProxy) getSite(ctx context.Context, id string) (*platformclientv2.Site, *platformclientv2.APIResponse, error) {
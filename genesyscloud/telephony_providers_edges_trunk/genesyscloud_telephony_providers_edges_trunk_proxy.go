@@ -2,6 +2,7 @@ package telephony_providers_edges_trunk
 
 import (
 	"context"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
 )
@@ -51,14 +52,13 @@ func newTrunkProxy(clientConfig *platformclientv2.Configuration) *trunkProxy {
 	}
 }
 
-// getTeamProxy acts as a singleton to for the internalProxy.  It also ensures
+// getTeamProxy returns the proxy of the provider instance that owns the client config.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getTrunkProxy(clientConfig *platformclientv2.Configuration) *trunkProxy {
-	if internalProxy == nil {
-		internalProxy = newTrunkProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return provider.GetProxy(clientConfig, newTrunkProxy)
 }
 
 func (p *trunkProxy) getEdge(ctx context.Context, edgeId string) (*platformclientv2.Edge, *platformclientv2.APIResponse, error) {
@@ -0,0 +1,259 @@
+package tfexporter
+
+import (
+	"encoding/json"
+	"log"
+	"path/filepath"
+	"sort"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+/*
+This file contains the logic for breaking cyclic dependencies between exported resources. The dependent consumers of flows are added
+to the config as depends_on attributes, so flows that call each other make a cycle that Terraform cannot plan. When break_cyclic_deps
+is enabled, every set of resources that depend on each other is found and the depends_on entries that close a cycle are left out of
+the config. The resources of a cycle are then created in the order of their remaining dependencies. The cycles, the depends_on
+entries that were left out and the order in which the resources are applied are written to the export directory, so objects that
+need their dependency to exist when they are created, e.g. flows that are published, can be applied again once the cycle is complete.
+*/
+
+type dependencyCycle struct {
+	// IDs of the resources in the cycle
+	ids []string
+	// depends_on entries left out of the config, keyed by the ID of the dependent resource
+	removed map[string][]string
+	// IDs of the resources in the order they are applied
+	applyOrder []string
+}
+
+type cyclicDependenciesReport struct {
+	Cycles []cyclicDependencyReport `json:"cycles"`
+}
+
+type cyclicDependencyReport struct {
+	Resources        []string               `json:"resources"`
+	RemovedDependsOn []removedDependsOnEdge `json:"removed_depends_on"`
+	ApplyOrder       []string               `json:"apply_order"`
+}
+
+type removedDependsOnEdge struct {
+	Resource  string `json:"resource"`
+	DependsOn string `json:"depends_on"`
+}
+
+// breakDependencyCycles leaves depends_on entries out of the config until the dependencies of the exported resources have no cycles
+func (g *GenesysCloudResourceExporter) breakDependencyCycles() {
+	if g.removedDependsOn == nil {
+		g.removedDependsOn = make(map[string]map[string]bool)
+	}
+	graph := make(map[string][]string)
+	for id, dependencies := range g.dependsList {
+		for _, dependency := range dependencies {
+			if !g.removedDependsOn[id][dependency] {
+				graph[id] = append(graph[id], dependency)
+			}
+		}
+	}
+
+	for _, cycle := range findDependencyCycles(graph) {
+		for id, dependencies := range cycle.removed {
+			if g.removedDependsOn[id] == nil {
+				g.removedDependsOn[id] = make(map[string]bool)
+			}
+			for _, dependency := range dependencies {
+				log.Printf("Breaking cyclic dependency by removing %s from the depends_on of %s", dependency, id)
+				g.removedDependsOn[id][dependency] = true
+			}
+		}
+		g.dependencyCycles = append(g.dependencyCycles, cycle)
+	}
+}
+
+// findDependencyCycles returns the cycles of a graph of resource IDs to their dependencies in the form {resource_type}.{id}. The
+// strongly connected components of the graph are found with Tarjan's algorithm, and the dependencies that point back to a resource
+// still being visited by a depth first search of each component are removed. Resources are visited in order of their ID so the
+// same dependencies are removed from every export.
+func findDependencyCycles(graph map[string][]string) []*dependencyCycle {
+	edges := make(map[string][]string)
+	nodes := make(map[string]bool)
+	for id, dependencies := range graph {
+		nodes[id] = true
+		for _, dependency := range dependencies {
+			depId := dependencyId(dependency)
+			edges[id] = append(edges[id], depId)
+			nodes[depId] = true
+		}
+	}
+	sortedNodes := make([]string, 0, len(nodes))
+	for id := range nodes {
+		sortedNodes = append(sortedNodes, id)
+	}
+	sort.Strings(sortedNodes)
+	for id := range edges {
+		sort.Strings(edges[id])
+	}
+
+	// Tarjan's strongly connected components
+	index := 0
+	indexes := make(map[string]int)
+	lowLinks := make(map[string]int)
+	onStack := make(map[string]bool)
+	stack := make([]string, 0)
+	components := make([][]string, 0)
+	var connect func(id string)
+	connect = func(id string) {
+		indexes[id] = index
+		lowLinks[id] = index
+		index++
+		stack = append(stack, id)
+		onStack[id] = true
+		for _, depId := range edges[id] {
+			if _, visited := indexes[depId]; !visited {
+				connect(depId)
+				if lowLinks[depId] < lowLinks[id] {
+					lowLinks[id] = lowLinks[depId]
+				}
+			} else if onStack[depId] && indexes[depId] < lowLinks[id] {
+				lowLinks[id] = indexes[depId]
+			}
+		}
+		if lowLinks[id] == indexes[id] {
+			component := make([]string, 0)
+			for {
+				member := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[member] = false
+				component = append(component, member)
+				if member == id {
+					break
+				}
+			}
+			if len(component) > 1 {
+				sort.Strings(component)
+				components = append(components, component)
+			}
+		}
+	}
+	for _, id := range sortedNodes {
+		if _, visited := indexes[id]; !visited {
+			connect(id)
+		}
+	}
+
+	cycles := make([]*dependencyCycle, 0, len(components))
+	for _, component := range components {
+		cycles = append(cycles, breakCycle(component, graph))
+	}
+	sort.Slice(cycles, func(i, j int) bool {
+		return cycles[i].ids[0] < cycles[j].ids[0]
+	})
+	return cycles
+}
+
+// breakCycle removes the dependencies between the resources of a strongly connected component that point back to a resource still
+// being visited. The resources finish in the order they are applied, as every resource finishes after its remaining dependencies.
+func breakCycle(component []string, graph map[string][]string) *dependencyCycle {
+	cycle := &dependencyCycle{ids: component, removed: make(map[string][]string)}
+	members := make(map[string]bool)
+	for _, id := range component {
+		members[id] = true
+	}
+
+	const (
+		visiting = 1
+		finished = 2
+	)
+	states := make(map[string]int)
+	var visit func(id string)
+	visit = func(id string) {
+		states[id] = visiting
+		dependencies := append([]string(nil), graph[id]...)
+		sort.Strings(dependencies)
+		for _, dependency := range dependencies {
+			depId := dependencyId(dependency)
+			if !members[depId] {
+				continue
+			}
+			switch states[depId] {
+			case visiting:
+				cycle.removed[id] = append(cycle.removed[id], dependency)
+			case 0:
+				visit(depId)
+			}
+		}
+		states[id] = finished
+		cycle.applyOrder = append(cycle.applyOrder, id)
+	}
+	for _, id := range component {
+		if states[id] == 0 {
+			visit(id)
+		}
+	}
+	return cycle
+}
+
+// dependencyId returns the ID of a dependency in the form {resource_type}.{id}
+func dependencyId(dependency string) string {
+	if _, id, found := strings.Cut(dependency, "."); found {
+		return id
+	}
+	return dependency
+}
+
+// isDependsOnRemoved returns true when a depends_on entry of a resource was left out to break a cycle
+func (g *GenesysCloudResourceExporter) isDependsOnRemoved(id string, dependency string) bool {
+	return g.removedDependsOn[id][dependency]
+}
+
+// buildCyclicDependenciesReport describes the cycles that were broken with the addresses of the exported resources
+func (g *GenesysCloudResourceExporter) buildCyclicDependenciesReport() *cyclicDependenciesReport {
+	addresses := make(map[string]string)
+	for _, resource := range g.resources {
+		if _, ok := addresses[resource.State.ID]; !ok {
+			addresses[resource.State.ID] = resource.Type + "." + resource.Name
+		}
+	}
+	address := func(id string) string {
+		if address, ok := addresses[id]; ok {
+			return address
+		}
+		return id
+	}
+
+	report := &cyclicDependenciesReport{Cycles: make([]cyclicDependencyReport, 0, len(g.dependencyCycles))}
+	for _, cycle := range g.dependencyCycles {
+		cycleReport := cyclicDependencyReport{
+			Resources:        make([]string, 0, len(cycle.ids)),
+			RemovedDependsOn: make([]removedDependsOnEdge, 0),
+			ApplyOrder:       make([]string, 0, len(cycle.applyOrder)),
+		}
+		for _, id := range cycle.ids {
+			cycleReport.Resources = append(cycleReport.Resources, address(id))
+			for _, dependency := range cycle.removed[id] {
+				cycleReport.RemovedDependsOn = append(cycleReport.RemovedDependsOn, removedDependsOnEdge{
+					Resource:  address(id),
+					DependsOn: address(dependencyId(dependency)),
+				})
+			}
+		}
+		for _, id := range cycle.applyOrder {
+			cycleReport.ApplyOrder = append(cycleReport.ApplyOrder, address(id))
+		}
+		report.Cycles = append(report.Cycles, cycleReport)
+	}
+	return report
+}
+
+func (r *cyclicDependenciesReport) write(dirPath string) diag.Diagnostics {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return diag.Errorf("Failed to encode cyclic dependencies as JSON: %v", err)
+	}
+
+	reportPath := filepath.Join(dirPath, defaultCyclicDependenciesFile)
+	log.Printf("Writing cyclic dependencies to %s", reportPath)
+	return files.WriteToFile(data, reportPath)
+}
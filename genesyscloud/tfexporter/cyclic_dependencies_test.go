@@ -0,0 +1,73 @@
+package tfexporter
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestUnitBreakDependencyCycles verifies cyclic depends_on entries are left out of the config and reported with the apply order
+func TestUnitBreakDependencyCycles(t *testing.T) {
+	dir := t.TempDir()
+	flow := func(id string, name string) resourceExporter.ResourceInfo {
+		return resourceExporter.ResourceInfo{Type: "genesyscloud_flow", Name: name, State: &terraform.InstanceState{ID: id}}
+	}
+	g := &GenesysCloudResourceExporter{
+		resources: []resourceExporter.ResourceInfo{
+			flow("a", "Main_Menu"),
+			flow("b", "Billing"),
+			flow("c", "Support"),
+			flow("d", "Common"),
+		},
+		// Main_Menu and Billing call each other, Billing calls Support which calls Main_Menu, and all of them call Common
+		dependsList: map[string][]string{
+			"a": {"genesyscloud_flow.b", "genesyscloud_flow.d"},
+			"b": {"genesyscloud_flow.a", "genesyscloud_flow.c", "genesyscloud_flow.d"},
+			"c": {"genesyscloud_flow.a", "genesyscloud_flow.d"},
+		},
+	}
+
+	g.breakDependencyCycles()
+	if assert.Len(t, g.dependencyCycles, 1) {
+		assert.Equal(t, []string{"a", "b", "c"}, g.dependencyCycles[0].ids)
+	}
+	assert.True(t, g.isDependsOnRemoved("b", "genesyscloud_flow.a"))
+	assert.True(t, g.isDependsOnRemoved("c", "genesyscloud_flow.a"))
+	assert.False(t, g.isDependsOnRemoved("a", "genesyscloud_flow.b"))
+	assert.False(t, g.isDependsOnRemoved("b", "genesyscloud_flow.d"))
+
+	// The remaining dependencies have no cycles, so breaking them again changes nothing
+	g.breakDependencyCycles()
+	assert.Len(t, g.dependencyCycles, 1)
+
+	configMap := util.JsonMap{}
+	g.addDependsOnValues("b", configMap)
+	assert.Equal(t, []string{"$dep$genesyscloud_flow.Support$dep$", "$dep$genesyscloud_flow.Common$dep$"}, configMap["depends_on"])
+
+	if diagErr := g.buildCyclicDependenciesReport().write(dir); diagErr != nil {
+		t.Fatalf("failed to write cyclic dependencies: %v", diagErr)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, defaultCyclicDependenciesFile))
+	if err != nil {
+		t.Fatalf("failed to read cyclic dependencies: %v", err)
+	}
+	var report cyclicDependenciesReport
+	if err := json.Unmarshal(data, &report); err != nil {
+		t.Fatalf("failed to parse cyclic dependencies: %v", err)
+	}
+	assert.Equal(t, cyclicDependenciesReport{Cycles: []cyclicDependencyReport{{
+		Resources: []string{"genesyscloud_flow.Main_Menu", "genesyscloud_flow.Billing", "genesyscloud_flow.Support"},
+		RemovedDependsOn: []removedDependsOnEdge{
+			{Resource: "genesyscloud_flow.Billing", DependsOn: "genesyscloud_flow.Main_Menu"},
+			{Resource: "genesyscloud_flow.Support", DependsOn: "genesyscloud_flow.Main_Menu"},
+		},
+		ApplyOrder: []string{"genesyscloud_flow.Support", "genesyscloud_flow.Billing", "genesyscloud_flow.Main_Menu"},
+	}}}, report)
+}
@@ -0,0 +1,275 @@
+package tfexporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"path/filepath"
+	"sort"
+	"strings"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+/*
+This file contains the logic for the dependency graph of an export. The graph has a node for every exported object and a typed edge
+for every reference between objects. References are found with the same settings the exporter uses to resolve them: the RefAttrs of
+the exporters, the EncodedRefAttrs nested in JSON encoded attributes, and the dependent consumers of flows retrieved when dependency
+resolution is enabled. Objects that are referenced but not part of the export get a node as well, so the graph can be used for impact
+analysis before an object is retired. The graph is written to the export directory as Graphviz DOT and as JSON.
+*/
+
+const (
+	edgeKindReference         = "reference"
+	edgeKindEncodedReference  = "encoded_reference"
+	edgeKindDependentConsumer = "dependent_consumer"
+)
+
+type dependencyGraph struct {
+	Nodes []*dependencyGraphNode `json:"nodes"`
+	Edges []dependencyGraphEdge  `json:"edges"`
+
+	nodes map[string]*dependencyGraphNode
+	edges map[dependencyGraphEdge]bool
+}
+
+type dependencyGraphNode struct {
+	// Key of the node in the form {resource_type}::{id}
+	Key          string `json:"key"`
+	ResourceType string `json:"resource_type"`
+	Id           string `json:"id"`
+	// Terraform address of the exported object. Empty for objects that are not part of the export.
+	Address    string `json:"address,omitempty"`
+	DataSource bool   `json:"data_source,omitempty"`
+	Exported   bool   `json:"exported"`
+}
+
+type dependencyGraphEdge struct {
+	From      string `json:"from"`
+	To        string `json:"to"`
+	Kind      string `json:"kind"`
+	Attribute string `json:"attribute,omitempty"`
+}
+
+func dependencyGraphKey(resType string, id string) string {
+	return resType + "::" + id
+}
+
+// newDependencyGraph builds the dependency graph of the exported resources
+func newDependencyGraph(resources []resourceExporter.ResourceInfo, exporters map[string]*resourceExporter.ResourceExporter, dependsList map[string][]string, isDataSource func(resType string, name string) bool) *dependencyGraph {
+	graph := &dependencyGraph{
+		Nodes: make([]*dependencyGraphNode, 0),
+		Edges: make([]dependencyGraphEdge, 0),
+		nodes: make(map[string]*dependencyGraphNode),
+		edges: make(map[dependencyGraphEdge]bool),
+	}
+
+	nodesById := make(map[string][]*dependencyGraphNode)
+	for _, resource := range resources {
+		if resource.State == nil {
+			continue
+		}
+		node := graph.node(resource.Type, resource.State.ID)
+		node.Exported = true
+		node.Address = resource.Type + "." + resource.Name
+		if isDataSource != nil && isDataSource(resource.Type, resource.Name) {
+			node.DataSource = true
+			node.Address = "data." + node.Address
+		}
+		nodesById[resource.State.ID] = append(nodesById[resource.State.ID], node)
+	}
+
+	for _, resource := range resources {
+		exporter := exporters[resource.Type]
+		if resource.State == nil || exporter == nil {
+			continue
+		}
+		from := dependencyGraphKey(resource.Type, resource.State.ID)
+		for key, value := range resource.State.Attributes {
+			attribute, ok := flatmapAttributePath(key)
+			if !ok || value == "" {
+				continue
+			}
+			if refSettings := refAttrSettingsForPath(exporter, attribute); refSettings != nil {
+				graph.addReference(from, attribute, edgeKindReference, refSettings, value)
+			}
+			for encodedAttr, refSettings := range exporter.EncodedRefAttrs {
+				if encodedAttr.Attr != attribute || refSettings == nil {
+					continue
+				}
+				for _, refID := range encodedReferenceIds(value, encodedAttr.NestedAttr) {
+					graph.addReference(from, attribute+"."+encodedAttr.NestedAttr, edgeKindEncodedReference, refSettings, refID)
+				}
+			}
+		}
+	}
+
+	// The dependent consumers of an object are keyed by its ID, see addDependsOnValues
+	for id, dependencies := range dependsList {
+		for _, node := range nodesById[id] {
+			for _, dependency := range dependencies {
+				resType, refID, found := strings.Cut(dependency, ".")
+				if !found {
+					continue
+				}
+				graph.addEdge(dependencyGraphEdge{From: node.Key, To: graph.node(resType, refID).Key, Kind: edgeKindDependentConsumer})
+			}
+		}
+	}
+
+	sort.Slice(graph.Nodes, func(i, j int) bool {
+		return graph.Nodes[i].Key < graph.Nodes[j].Key
+	})
+	sort.Slice(graph.Edges, func(i, j int) bool {
+		a, b := graph.Edges[i], graph.Edges[j]
+		if a.From != b.From {
+			return a.From < b.From
+		}
+		if a.To != b.To {
+			return a.To < b.To
+		}
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		return a.Attribute < b.Attribute
+	})
+	return graph
+}
+
+// node returns the node of an object, adding it to the graph if needed
+func (g *dependencyGraph) node(resType string, id string) *dependencyGraphNode {
+	key := dependencyGraphKey(resType, id)
+	if node, ok := g.nodes[key]; ok {
+		return node
+	}
+	node := &dependencyGraphNode{Key: key, ResourceType: resType, Id: id}
+	g.nodes[key] = node
+	g.Nodes = append(g.Nodes, node)
+	return node
+}
+
+func (g *dependencyGraph) addReference(from string, attribute string, kind string, refSettings *resourceExporter.RefAttrSettings, refID string) {
+	if refID == "" || lists.ItemInSlice(refID, refSettings.AltValues) {
+		// Not a reference to another object
+		return
+	}
+	g.addEdge(dependencyGraphEdge{From: from, To: g.node(refSettings.RefType, refID).Key, Kind: kind, Attribute: attribute})
+}
+
+func (g *dependencyGraph) addEdge(edge dependencyGraphEdge) {
+	if g.edges[edge] {
+		return
+	}
+	g.edges[edge] = true
+	g.Edges = append(g.Edges, edge)
+}
+
+// flatmapAttributePath returns the attribute path of a key of the flattened state without the indexes of lists and sets,
+// e.g. members.0.user_id becomes members.user_id. Keys holding the size of a collection are ignored.
+func flatmapAttributePath(key string) (string, bool) {
+	parts := strings.Split(key, ".")
+	if last := parts[len(parts)-1]; last == "#" || last == "%" {
+		return "", false
+	}
+	path := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if isIndex(part) {
+			continue
+		}
+		path = append(path, part)
+	}
+	return strings.Join(path, "."), len(path) > 0
+}
+
+func isIndex(part string) bool {
+	if part == "" {
+		return false
+	}
+	for _, c := range part {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// refAttrSettingsForPath returns the settings of a reference attribute, including the wildcard settings of all attributes of a map
+func refAttrSettingsForPath(exporter *resourceExporter.ResourceExporter, attribute string) *resourceExporter.RefAttrSettings {
+	if refSettings := exporter.GetRefAttrSettings(attribute); refSettings != nil {
+		return refSettings
+	}
+	wildcardAttr := "*"
+	if idx := strings.LastIndex(attribute, "."); idx != -1 {
+		wildcardAttr = attribute[:idx] + ".*"
+	}
+	return exporter.GetRefAttrSettings(wildcardAttr)
+}
+
+// encodedReferenceIds returns the IDs referenced by a nested attribute of a JSON encoded attribute
+func encodedReferenceIds(value string, nestedAttr string) []string {
+	var data map[string]interface{}
+	if err := json.Unmarshal([]byte(value), &data); err != nil {
+		return nil
+	}
+	var ids []string
+	switch v := data[nestedAttr].(type) {
+	case string:
+		ids = append(ids, v)
+	case []interface{}:
+		for _, item := range v {
+			if id, ok := item.(string); ok {
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids
+}
+
+// dot returns the graph in the Graphviz DOT language. Exported objects are labelled with their address, and objects that are not
+// part of the export are drawn dashed.
+func (g *dependencyGraph) dot() []byte {
+	var b strings.Builder
+	b.WriteString("digraph genesyscloud {\n")
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=box];\n")
+	for _, node := range g.Nodes {
+		if node.Exported {
+			fmt.Fprintf(&b, "  %s [label=%s];\n", dotQuote(node.Key), dotQuote(node.Address))
+		} else {
+			fmt.Fprintf(&b, "  %s [label=%s, style=dashed];\n", dotQuote(node.Key), dotQuote(node.Key))
+		}
+	}
+	for _, edge := range g.Edges {
+		switch edge.Kind {
+		case edgeKindDependentConsumer:
+			fmt.Fprintf(&b, "  %s -> %s [label=\"dependent consumer\", style=dotted];\n", dotQuote(edge.From), dotQuote(edge.To))
+		case edgeKindEncodedReference:
+			fmt.Fprintf(&b, "  %s -> %s [label=%s, style=dashed];\n", dotQuote(edge.From), dotQuote(edge.To), dotQuote(edge.Attribute))
+		default:
+			fmt.Fprintf(&b, "  %s -> %s [label=%s];\n", dotQuote(edge.From), dotQuote(edge.To), dotQuote(edge.Attribute))
+		}
+	}
+	b.WriteString("}\n")
+	return []byte(b.String())
+}
+
+func dotQuote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+func (g *dependencyGraph) write(dirPath string) diag.Diagnostics {
+	data, err := json.MarshalIndent(g, "", "  ")
+	if err != nil {
+		return diag.Errorf("Failed to encode dependency graph as JSON: %v", err)
+	}
+
+	graphPath := filepath.Join(dirPath, defaultDependencyGraphJSONFile)
+	log.Printf("Writing dependency graph to %s", graphPath)
+	if diagErr := files.WriteToFile(data, graphPath); diagErr != nil {
+		return diagErr
+	}
+	return files.WriteToFile(g.dot(), filepath.Join(dirPath, defaultDependencyGraphDOTFile))
+}
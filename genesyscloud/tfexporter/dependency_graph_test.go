@@ -0,0 +1,103 @@
+package tfexporter
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestUnitDependencyGraph verifies the dependency graph has a node for every exported object and an edge for every reference
+func TestUnitDependencyGraph(t *testing.T) {
+	dir := t.TempDir()
+	exporters := map[string]*resourceExporter.ResourceExporter{
+		"genesyscloud_routing_queue": {
+			RefAttrs: map[string]*resourceExporter.RefAttrSettings{
+				"queue_flow_id":   {RefType: "genesyscloud_flow"},
+				"members.user_id": {RefType: "genesyscloud_user"},
+				"division_id":     {RefType: "genesyscloud_auth_division", AltValues: []string{"*"}},
+			},
+		},
+		"genesyscloud_integration": {
+			RefAttrs: map[string]*resourceExporter.RefAttrSettings{
+				"config.credentials.*": {RefType: "genesyscloud_integration_credential"},
+			},
+			EncodedRefAttrs: map[*resourceExporter.JsonEncodeRefAttr]*resourceExporter.RefAttrSettings{
+				{Attr: "config.properties", NestedAttr: "groups"}: {RefType: "genesyscloud_group"},
+			},
+		},
+		"genesyscloud_flow": {},
+		"genesyscloud_user": {},
+	}
+	resources := []resourceExporter.ResourceInfo{
+		{Type: "genesyscloud_routing_queue", Name: "Sales", State: &terraform.InstanceState{ID: "queue-1", Attributes: map[string]string{
+			"queue_flow_id":                "flow-1",
+			"division_id":                  "*",
+			"members.#":                    "2",
+			"members.1234.user_id":         "user-1",
+			"members.5678.user_id":         "user-2",
+			"members.5678.ring_num":        "1",
+			"outbound_email_address.#":     "0",
+			"outbound_email_address.0.foo": "",
+		}}},
+		{Type: "genesyscloud_integration", Name: "Webhook", State: &terraform.InstanceState{ID: "integration-1", Attributes: map[string]string{
+			"config.0.credentials.%":         "1",
+			"config.0.credentials.basicAuth": "credential-1",
+			"config.0.properties":            `{"groups":["group-1"],"url":"https://example.com"}`,
+		}}},
+		{Type: "genesyscloud_flow", Name: "Inqueue", State: &terraform.InstanceState{ID: "flow-1", Attributes: map[string]string{}}},
+		{Type: "genesyscloud_user", Name: "Agent", State: &terraform.InstanceState{ID: "user-1", Attributes: map[string]string{}}},
+	}
+	dependsList := map[string][]string{"flow-1": {"genesyscloud_user.user-1"}}
+	isDataSource := func(resType string, name string) bool {
+		return resType == "genesyscloud_user"
+	}
+
+	graph := newDependencyGraph(resources, exporters, dependsList, isDataSource)
+	if diagErr := graph.write(dir); diagErr != nil {
+		t.Fatalf("failed to write dependency graph: %v", diagErr)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, defaultDependencyGraphJSONFile))
+	if err != nil {
+		t.Fatalf("failed to read dependency graph: %v", err)
+	}
+	var written dependencyGraph
+	if err := json.Unmarshal(data, &written); err != nil {
+		t.Fatalf("failed to parse dependency graph: %v", err)
+	}
+
+	nodes := make(map[string]dependencyGraphNode)
+	for _, node := range written.Nodes {
+		nodes[node.Key] = *node
+	}
+	assert.Len(t, nodes, 7)
+	assert.Equal(t, dependencyGraphNode{Key: "genesyscloud_routing_queue::queue-1", ResourceType: "genesyscloud_routing_queue", Id: "queue-1", Address: "genesyscloud_routing_queue.Sales", Exported: true}, nodes["genesyscloud_routing_queue::queue-1"])
+	assert.Equal(t, "data.genesyscloud_user.Agent", nodes["genesyscloud_user::user-1"].Address)
+	assert.True(t, nodes["genesyscloud_user::user-1"].DataSource)
+	assert.False(t, nodes["genesyscloud_user::user-2"].Exported)
+	assert.False(t, nodes["genesyscloud_group::group-1"].Exported)
+
+	assert.Equal(t, []dependencyGraphEdge{
+		{From: "genesyscloud_flow::flow-1", To: "genesyscloud_user::user-1", Kind: edgeKindDependentConsumer},
+		{From: "genesyscloud_integration::integration-1", To: "genesyscloud_group::group-1", Kind: edgeKindEncodedReference, Attribute: "config.properties.groups"},
+		{From: "genesyscloud_integration::integration-1", To: "genesyscloud_integration_credential::credential-1", Kind: edgeKindReference, Attribute: "config.credentials.basicAuth"},
+		{From: "genesyscloud_routing_queue::queue-1", To: "genesyscloud_flow::flow-1", Kind: edgeKindReference, Attribute: "queue_flow_id"},
+		{From: "genesyscloud_routing_queue::queue-1", To: "genesyscloud_user::user-1", Kind: edgeKindReference, Attribute: "members.user_id"},
+		{From: "genesyscloud_routing_queue::queue-1", To: "genesyscloud_user::user-2", Kind: edgeKindReference, Attribute: "members.user_id"},
+	}, written.Edges)
+
+	dot, err := os.ReadFile(filepath.Join(dir, defaultDependencyGraphDOTFile))
+	if err != nil {
+		t.Fatalf("failed to read dependency graph: %v", err)
+	}
+	assert.Contains(t, string(dot), `"genesyscloud_routing_queue::queue-1" [label="genesyscloud_routing_queue.Sales"];`)
+	assert.Contains(t, string(dot), `"genesyscloud_user::user-2" [label="genesyscloud_user::user-2", style=dashed];`)
+	assert.Contains(t, string(dot), `"genesyscloud_routing_queue::queue-1" -> "genesyscloud_flow::flow-1" [label="queue_flow_id"];`)
+	assert.Contains(t, string(dot), `"genesyscloud_flow::flow-1" -> "genesyscloud_user::user-1" [label="dependent consumer", style=dotted];`)
+}
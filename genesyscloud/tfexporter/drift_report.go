@@ -0,0 +1,433 @@
+package tfexporter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+/*
+This file contains the logic to build a drift report between an existing terraform.tfstate file and the live org. Every managed
+object in the state is refreshed through its registered schema.Resource reader and compared attribute by attribute with the
+recorded state. Objects that no longer exist in the org are reported as deleted, and objects of the same resource types that exist
+in the org but are not in the state are reported as unmanaged. The report is written as both JSON and Markdown.
+*/
+
+type DriftReport struct {
+	StateFile string             `json:"state_file"`
+	Changed   []DriftedResource  `json:"changed"`
+	Deleted   []DriftResourceRef `json:"deleted_in_org"`
+	Unmanaged []DriftResourceRef `json:"unmanaged"`
+	Errors    []DriftResourceRef `json:"errors,omitempty"`
+
+	mutex  sync.Mutex
+	byType map[string][]*tfState
+}
+
+type DriftResourceRef struct {
+	Type    string `json:"type"`
+	Name    string `json:"name"`
+	Address string `json:"address,omitempty"`
+	Id      string `json:"id"`
+	Error   string `json:"error,omitempty"`
+}
+
+type DriftedResource struct {
+	DriftResourceRef
+	Attributes []AttributeDrift `json:"attributes"`
+}
+
+type AttributeDrift struct {
+	Attribute string      `json:"attribute"`
+	State     interface{} `json:"state"`
+	Live      interface{} `json:"live"`
+}
+
+// tfState is a managed resource instance read from a terraform.tfstate file. The address includes the module and the index key of
+// the instance, e.g. module.support.genesyscloud_routing_queue.queue["sales"].
+type tfState struct {
+	Type    string
+	Name    string
+	Address string
+	State   *terraform.InstanceState
+}
+
+// tfStateFile covers both the v4 state written by terraform 0.13+ and the v3 state written by TFStateFileWriter
+type tfStateFile struct {
+	Version   int `json:"version"`
+	Resources []struct {
+		Module    string `json:"module"`
+		Mode      string `json:"mode"`
+		Type      string `json:"type"`
+		Name      string `json:"name"`
+		Instances []struct {
+			IndexKey      interface{}            `json:"index_key"`
+			SchemaVersion int                    `json:"schema_version"`
+			Attributes    map[string]interface{} `json:"attributes"`
+		} `json:"instances"`
+	} `json:"resources"`
+	Modules []struct {
+		Path      []string                            `json:"path"`
+		Resources map[string]*terraform.ResourceState `json:"resources"`
+	} `json:"modules"`
+}
+
+// readManagedResources reads the managed genesyscloud resource instances from a terraform.tfstate file
+func readManagedResources(stateFilePath string, resources map[string]*schema.Resource) ([]*tfState, diag.Diagnostics) {
+	data, err := os.ReadFile(stateFilePath)
+	if err != nil {
+		return nil, diag.Errorf("Failed to read state file %s: %v", stateFilePath, err)
+	}
+
+	var stateFile tfStateFile
+	if err := json.Unmarshal(data, &stateFile); err != nil {
+		return nil, diag.Errorf("Failed to parse state file %s: %v", stateFilePath, err)
+	}
+
+	var managed []*tfState
+	if stateFile.Version < 4 {
+		for _, module := range stateFile.Modules {
+			modulePrefix := ""
+			for _, name := range module.Path {
+				if name != "root" {
+					modulePrefix += "module." + name + "."
+				}
+			}
+			for key, resourceState := range module.Resources {
+				if resourceState == nil || resourceState.Primary == nil || strings.HasPrefix(key, "data.") || resources[resourceState.Type] == nil {
+					continue
+				}
+				// Counted resources are keyed by type.name.index in v3 state files
+				name := strings.TrimPrefix(key, resourceState.Type+".")
+				var indexKey interface{}
+				if i := strings.LastIndex(name, "."); i >= 0 {
+					if index, err := strconv.Atoi(name[i+1:]); err == nil {
+						name, indexKey = name[:i], index
+					}
+				}
+				managed = append(managed, &tfState{
+					Type:    resourceState.Type,
+					Name:    name,
+					Address: modulePrefix + resourceAddress(resourceState.Type, name, indexKey),
+					State:   resourceState.Primary,
+				})
+			}
+		}
+		return managed, nil
+	}
+
+	for _, r := range stateFile.Resources {
+		res := resources[r.Type]
+		if r.Mode != "managed" || res == nil {
+			continue
+		}
+		schemaMap := res.SchemaMap()
+		for _, instance := range r.Instances {
+			// Drop attributes that are no longer part of the schema so older state files can still be read
+			attributes := make(map[string]interface{})
+			for attr, value := range instance.Attributes {
+				if _, ok := schemaMap[attr]; ok || attr == "id" {
+					attributes[attr] = value
+				}
+			}
+			stateVal, err := schema.JSONMapToStateValue(attributes, res.CoreConfigSchema())
+			if err != nil {
+				return nil, diag.Errorf("Failed to read state of %s.%s from %s: %v", r.Type, r.Name, stateFilePath, err)
+			}
+			address := resourceAddress(r.Type, r.Name, instance.IndexKey)
+			if r.Module != "" {
+				address = r.Module + "." + address
+			}
+			managed = append(managed, &tfState{
+				Type:    r.Type,
+				Name:    r.Name,
+				Address: address,
+				State:   terraform.NewInstanceStateShimmedFromValue(stateVal, instance.SchemaVersion),
+			})
+		}
+	}
+	return managed, nil
+}
+
+// resourceAddress returns the address of a resource instance in a module, with the index key of resources created with count or
+// for_each
+func resourceAddress(resType string, name string, indexKey interface{}) string {
+	address := resType + "." + name
+	switch key := indexKey.(type) {
+	case string:
+		address += fmt.Sprintf("[%q]", key)
+	case float64:
+		address += fmt.Sprintf("[%d]", int(key))
+	case int:
+		address += fmt.Sprintf("[%d]", key)
+	}
+	return address
+}
+
+// BuildDriftReport refreshes every managed object in the state file and compares it with the live org. At most maxConcurrentReads
+// objects are read at once, or as many as the client pool has clients when it is 0.
+func BuildDriftReport(ctx context.Context, stateFilePath string, resources map[string]*schema.Resource, includeUnmanaged bool, maxConcurrentReads int, meta interface{}) (*DriftReport, diag.Diagnostics) {
+	managed, diagErr := readManagedResources(stateFilePath, resources)
+	if diagErr != nil {
+		return nil, diagErr
+	}
+
+	report := &DriftReport{
+		StateFile: stateFilePath,
+		Changed:   make([]DriftedResource, 0),
+		Deleted:   make([]DriftResourceRef, 0),
+		Unmanaged: make([]DriftResourceRef, 0),
+		byType:    make(map[string][]*tfState),
+	}
+	for _, m := range managed {
+		report.byType[m.Type] = append(report.byType[m.Type], m)
+	}
+
+	log.Printf("Checking %d managed objects from %s for drift", len(managed), stateFilePath)
+	if maxConcurrentReads <= 0 {
+		maxConcurrentReads = 1
+		if metrics := provider.GetClientPoolMetrics(meta); metrics != nil && metrics.Size > 0 {
+			maxConcurrentReads = metrics.Size
+		}
+	}
+	pending := make(chan *tfState, len(managed))
+	for _, m := range managed {
+		pending <- m
+	}
+	close(pending)
+
+	var wg sync.WaitGroup
+	for i := 0; i < maxConcurrentReads && i < len(managed); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for m := range pending {
+				report.checkResource(ctx, resources[m.Type], m, meta)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if includeUnmanaged {
+		report.findUnmanaged(provider.WithClientPool(ctx, meta))
+	}
+
+	report.sort()
+	return report, nil
+}
+
+func (r *DriftReport) checkResource(ctx context.Context, res *schema.Resource, m *tfState, meta interface{}) {
+	ref := DriftResourceRef{Type: m.Type, Name: m.Name, Address: m.Address, Id: m.State.ID}
+	ctyType := res.CoreConfigSchema().ImpliedType()
+
+	stateMap, err := instanceStateToJsonMap(m.State, ctyType)
+	if err != nil {
+		r.addError(ref, err)
+		return
+	}
+
+	liveState, diagErr := refreshResourceState(ctx, res, m.State.DeepCopy(), meta)
+	if diagErr != nil {
+		r.addError(ref, fmt.Errorf("%v", diagErr))
+		return
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if liveState == nil {
+		r.Deleted = append(r.Deleted, ref)
+		return
+	}
+
+	liveMap, err := instanceStateToJsonMap(liveState, ctyType)
+	if err != nil {
+		ref.Error = err.Error()
+		r.Errors = append(r.Errors, ref)
+		return
+	}
+
+	if attributes := diffAttributes(stateMap, liveMap); len(attributes) > 0 {
+		r.Changed = append(r.Changed, DriftedResource{DriftResourceRef: ref, Attributes: attributes})
+	}
+}
+
+// findUnmanaged lists the objects of every resource type in the state and reports those the state does not manage
+func (r *DriftReport) findUnmanaged(ctx context.Context) {
+	exporters := resourceExporter.GetResourceExporters()
+	for resType, managed := range r.byType {
+		exporter := exporters[resType]
+		if exporter == nil || exporter.GetResourcesFunc == nil {
+			continue
+		}
+
+		objects, diagErr := exporter.GetResourcesFunc(ctx)
+		if diagErr != nil {
+			r.addError(DriftResourceRef{Type: resType}, fmt.Errorf("failed to list objects: %v", diagErr))
+			continue
+		}
+
+		managedIds := make(map[string]bool)
+		for _, m := range managed {
+			managedIds[m.State.ID] = true
+		}
+		for id, resMeta := range objects {
+			if managedIds[id] || managedIds[resMeta.IdPrefix+id] {
+				continue
+			}
+			r.Unmanaged = append(r.Unmanaged, DriftResourceRef{Type: resType, Name: resMeta.Name, Id: id})
+		}
+	}
+}
+
+func (r *DriftReport) addError(ref DriftResourceRef, err error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	ref.Error = err.Error()
+	r.Errors = append(r.Errors, ref)
+}
+
+// HasDrift returns true if any managed object changed or was deleted, or if unmanaged objects were found
+func (r *DriftReport) HasDrift() bool {
+	return len(r.Changed) > 0 || len(r.Deleted) > 0 || len(r.Unmanaged) > 0
+}
+
+func (r *DriftReport) sort() {
+	less := func(a, b DriftResourceRef) bool {
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		if a.Address != b.Address {
+			return a.Address < b.Address
+		}
+		return a.Id < b.Id
+	}
+	sort.Slice(r.Changed, func(i, j int) bool { return less(r.Changed[i].DriftResourceRef, r.Changed[j].DriftResourceRef) })
+	sort.Slice(r.Deleted, func(i, j int) bool { return less(r.Deleted[i], r.Deleted[j]) })
+	sort.Slice(r.Unmanaged, func(i, j int) bool { return less(r.Unmanaged[i], r.Unmanaged[j]) })
+	sort.Slice(r.Errors, func(i, j int) bool { return less(r.Errors[i], r.Errors[j]) })
+}
+
+// diffAttributes returns the top level attributes whose values differ between the recorded state and the live object
+func diffAttributes(stateMap util.JsonMap, liveMap util.JsonMap) []AttributeDrift {
+	keys := sortedKeys(stateMap)
+	for k := range liveMap {
+		if _, ok := stateMap[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	attributes := make([]AttributeDrift, 0)
+	for _, k := range keys {
+		if k == "id" {
+			continue
+		}
+		if !reflect.DeepEqual(stateMap[k], liveMap[k]) {
+			attributes = append(attributes, AttributeDrift{Attribute: k, State: stateMap[k], Live: liveMap[k]})
+		}
+	}
+	return attributes
+}
+
+// WriteDriftReport writes the report as JSON and Markdown files to the directory
+func WriteDriftReport(report *DriftReport, dirPath string) diag.Diagnostics {
+	if err := os.MkdirAll(dirPath, os.ModePerm); err != nil {
+		return diag.FromErr(err)
+	}
+
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return diag.Errorf("Failed to encode drift report as JSON: %v", err)
+	}
+	jsonPath := filepath.Join(dirPath, defaultDriftReportJSONFile)
+	log.Printf("Writing drift report to %s", jsonPath)
+	if diagErr := files.WriteToFile(data, jsonPath); diagErr != nil {
+		return diagErr
+	}
+
+	markdownPath := filepath.Join(dirPath, defaultDriftReportMarkdownFile)
+	log.Printf("Writing drift report to %s", markdownPath)
+	return files.WriteToFile([]byte(report.markdown()), markdownPath)
+}
+
+func (r *DriftReport) markdown() string {
+	var sb strings.Builder
+	sb.WriteString("# Drift Report\n\n")
+	sb.WriteString(fmt.Sprintf("State file: `%s`\n\n", r.StateFile))
+	sb.WriteString("| Changed | Deleted in org | Unmanaged | Errors |\n|---|---|---|---|\n")
+	sb.WriteString(fmt.Sprintf("| %d | %d | %d | %d |\n", len(r.Changed), len(r.Deleted), len(r.Unmanaged), len(r.Errors)))
+
+	if len(r.Changed) > 0 {
+		sb.WriteString("\n## Changed\n")
+		for _, changed := range r.Changed {
+			address := changed.Address
+			if address == "" {
+				address = changed.Type + "." + changed.Name
+			}
+			sb.WriteString(fmt.Sprintf("\n### %s (`%s`)\n\n", address, changed.Id))
+			sb.WriteString("| Attribute | State | Live |\n|---|---|---|\n")
+			for _, attr := range changed.Attributes {
+				sb.WriteString(fmt.Sprintf("| `%s` | %s | %s |\n", attr.Attribute, markdownValue(attr.State), markdownValue(attr.Live)))
+			}
+		}
+	}
+
+	writeRefs := func(title string, refs []DriftResourceRef) {
+		if len(refs) == 0 {
+			return
+		}
+		sb.WriteString(fmt.Sprintf("\n## %s\n\n", title))
+		for _, ref := range refs {
+			line := fmt.Sprintf("- `%s`", ref.Type)
+			if ref.Address != "" {
+				line = fmt.Sprintf("- `%s`", ref.Address)
+			} else if ref.Name != "" {
+				line += fmt.Sprintf(" %s", ref.Name)
+			}
+			if ref.Id != "" {
+				line += fmt.Sprintf(" (`%s`)", ref.Id)
+			}
+			if ref.Error != "" {
+				line += ": " + strings.ReplaceAll(ref.Error, "\n", " ")
+			}
+			sb.WriteString(line + "\n")
+		}
+	}
+	writeRefs("Deleted in Org", r.Deleted)
+	writeRefs("Unmanaged", r.Unmanaged)
+	writeRefs("Errors", r.Errors)
+
+	return sb.String()
+}
+
+// markdownValue formats an attribute value as JSON for a Markdown table cell
+func markdownValue(v interface{}) string {
+	if v == nil {
+		return "_null_"
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return "`" + strings.ReplaceAll(string(data), "|", "\\|") + "`"
+}
@@ -0,0 +1,188 @@
+package tfexporter
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func driftTestResources() map[string]*schema.Resource {
+	return map[string]*schema.Resource{
+		"genesyscloud_routing_queue": {
+			Schema: map[string]*schema.Schema{
+				"name":        {Type: schema.TypeString, Required: true},
+				"description": {Type: schema.TypeString, Optional: true},
+				"skill_ids":   {Type: schema.TypeList, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			},
+		},
+	}
+}
+
+// TestUnitReadManagedResources verifies managed resources are read from both v4 and v3 state files
+func TestUnitReadManagedResources(t *testing.T) {
+	dir := t.TempDir()
+	v4State := `{
+  "version": 4,
+  "resources": [
+    {
+      "mode": "managed",
+      "type": "genesyscloud_routing_queue",
+      "name": "support",
+      "instances": [{"schema_version": 0, "attributes": {"id": "queue-1", "name": "Support", "description": "Support queue", "skill_ids": ["skill-1"], "removed_attr": "x"}}]
+    },
+    {
+      "mode": "data",
+      "type": "genesyscloud_routing_queue",
+      "name": "sales",
+      "instances": [{"schema_version": 0, "attributes": {"id": "queue-2", "name": "Sales"}}]
+    },
+    {
+      "mode": "managed",
+      "type": "genesyscloud_unknown",
+      "name": "unknown",
+      "instances": [{"schema_version": 0, "attributes": {"id": "unknown-1"}}]
+    }
+  ]
+}`
+	v3State := `{
+  "version": 3,
+  "modules": [
+    {
+      "path": ["root"],
+      "resources": {
+        "genesyscloud_routing_queue.support": {
+          "type": "genesyscloud_routing_queue",
+          "primary": {"id": "queue-1", "attributes": {"id": "queue-1", "name": "Support", "description": "Support queue", "skill_ids.#": "1", "skill_ids.0": "skill-1"}}
+        }
+      }
+    }
+  ]
+}`
+
+	for version, content := range map[string]string{"v4": v4State, "v3": v3State} {
+		statePath := filepath.Join(dir, version+".tfstate")
+		if err := os.WriteFile(statePath, []byte(content), os.ModePerm); err != nil {
+			t.Fatal(err)
+		}
+
+		managed, diagErr := readManagedResources(statePath, driftTestResources())
+		if diagErr != nil {
+			t.Fatalf("%s: unexpected error reading state: %v", version, diagErr)
+		}
+		if len(managed) != 1 {
+			t.Fatalf("%s: expected 1 managed resource, got %d", version, len(managed))
+		}
+
+		m := managed[0]
+		if m.Type != "genesyscloud_routing_queue" || m.Name != "support" || m.Address != "genesyscloud_routing_queue.support" || m.State.ID != "queue-1" {
+			t.Errorf("%s: unexpected managed resource %s.%s (%s)", version, m.Type, m.Name, m.State.ID)
+		}
+		if m.State.Attributes["description"] != "Support queue" || m.State.Attributes["skill_ids.0"] != "skill-1" {
+			t.Errorf("%s: unexpected attributes %v", version, m.State.Attributes)
+		}
+	}
+}
+
+// TestUnitReadManagedResourceAddresses verifies resources of child modules and resources created with count or for_each get their
+// full address
+func TestUnitReadManagedResourceAddresses(t *testing.T) {
+	v4State := `{
+  "version": 4,
+  "resources": [
+    {
+      "mode": "managed",
+      "type": "genesyscloud_routing_queue",
+      "name": "queue",
+      "instances": [
+        {"index_key": 0, "schema_version": 0, "attributes": {"id": "queue-1", "name": "Support"}},
+        {"index_key": 1, "schema_version": 0, "attributes": {"id": "queue-2", "name": "Sales"}}
+      ]
+    },
+    {
+      "module": "module.team",
+      "mode": "managed",
+      "type": "genesyscloud_routing_queue",
+      "name": "queue",
+      "instances": [{"index_key": "billing", "schema_version": 0, "attributes": {"id": "queue-3", "name": "Billing"}}]
+    },
+    {
+      "module": "module.team",
+      "mode": "managed",
+      "type": "genesyscloud_routing_queue",
+      "name": "support",
+      "instances": [{"schema_version": 0, "attributes": {"id": "queue-4", "name": "Support"}}]
+    }
+  ]
+}`
+	statePath := filepath.Join(t.TempDir(), "terraform.tfstate")
+	if err := os.WriteFile(statePath, []byte(v4State), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+
+	managed, diagErr := readManagedResources(statePath, driftTestResources())
+	if diagErr != nil {
+		t.Fatalf("unexpected error reading state: %v", diagErr)
+	}
+	addresses := make(map[string]string)
+	for _, m := range managed {
+		addresses[m.State.ID] = m.Address
+	}
+	expected := map[string]string{
+		"queue-1": "genesyscloud_routing_queue.queue[0]",
+		"queue-2": "genesyscloud_routing_queue.queue[1]",
+		"queue-3": `module.team.genesyscloud_routing_queue.queue["billing"]`,
+		"queue-4": "module.team.genesyscloud_routing_queue.support",
+	}
+	if !reflect.DeepEqual(expected, addresses) {
+		t.Errorf("expected addresses %v, got %v", expected, addresses)
+	}
+
+	report := &DriftReport{Deleted: []DriftResourceRef{{Type: "genesyscloud_routing_queue", Name: "queue", Address: expected["queue-3"], Id: "queue-3"}}}
+	if markdown := report.markdown(); !strings.Contains(markdown, "- `module.team.genesyscloud_routing_queue.queue[\"billing\"]` (`queue-3`)") {
+		t.Errorf("expected the markdown report to list the address of the deleted object:\n%s", markdown)
+	}
+}
+
+// TestUnitDriftReportDiffAndMarkdown verifies changed attributes are reported and rendered in the Markdown report
+func TestUnitDriftReportDiffAndMarkdown(t *testing.T) {
+	stateMap := map[string]interface{}{"id": "queue-1", "name": "Support", "description": "Support queue", "skill_ids": []interface{}{"skill-1"}}
+	liveMap := map[string]interface{}{"id": "queue-1", "name": "Support", "description": "Edited | in the UI", "skill_ids": []interface{}{"skill-1", "skill-2"}}
+
+	attributes := diffAttributes(stateMap, liveMap)
+	if len(attributes) != 2 || attributes[0].Attribute != "description" || attributes[1].Attribute != "skill_ids" {
+		t.Fatalf("unexpected attribute drift %v", attributes)
+	}
+	if len(diffAttributes(stateMap, stateMap)) != 0 {
+		t.Errorf("expected no drift for identical attributes")
+	}
+
+	report := &DriftReport{
+		StateFile: "terraform.tfstate",
+		Changed:   []DriftedResource{{DriftResourceRef: DriftResourceRef{Type: "genesyscloud_routing_queue", Name: "support", Id: "queue-1"}, Attributes: attributes}},
+		Deleted:   []DriftResourceRef{{Type: "genesyscloud_routing_queue", Name: "billing", Id: "queue-3"}},
+		Unmanaged: []DriftResourceRef{},
+	}
+	if !report.HasDrift() {
+		t.Errorf("expected the report to have drift")
+	}
+
+	markdown := report.markdown()
+	for _, expected := range []string{
+		"| 1 | 1 | 0 | 0 |",
+		"### genesyscloud_routing_queue.support (`queue-1`)",
+		"| `description` | `\"Support queue\"` | `\"Edited \\| in the UI\"` |",
+		"## Deleted in Org",
+		"- `genesyscloud_routing_queue` billing (`queue-3`)",
+	} {
+		if !strings.Contains(markdown, expected) {
+			t.Errorf("expected markdown report to contain %q:\n%s", expected, markdown)
+		}
+	}
+	if strings.Contains(markdown, "## Unmanaged") {
+		t.Errorf("expected no unmanaged section in the markdown report")
+	}
+}
@@ -17,16 +17,60 @@ import (
 )
 
 const (
-	defaultTfJSONFile          = "genesyscloud.tf.json"
-	defaultTfHCLFile           = "genesyscloud.tf"
-	defaultTfHCLProviderFile   = "provider.tf"
-	defaultTfJSONProviderFile  = "provider.tf.json"
-	defaultTfHCLVariablesFile  = "variables.tf"
-	defaultTfJSONVariablesFile = "variables.tf.json"
-	defaultTfVarsFile          = "terraform.tfvars"
-	defaultTfStateFile         = "terraform.tfstate"
+	defaultTfJSONFile              = "genesyscloud.tf.json"
+	defaultTfHCLFile               = "genesyscloud.tf"
+	defaultTfHCLProviderFile       = "provider.tf"
+	defaultTfJSONProviderFile      = "provider.tf.json"
+	defaultTfHCLVariablesFile      = "variables.tf"
+	defaultTfJSONVariablesFile     = "variables.tf.json"
+	defaultTfVarsFile              = "terraform.tfvars"
+	defaultTfHCLImportsFile        = "imports.tf"
+	defaultTfJSONImportsFile       = "imports.tf.json"
+	defaultTfHCLMovedFile          = "moved.tf"
+	defaultTfJSONMovedFile         = "moved.tf.json"
+	defaultTfStateFile             = "terraform.tfstate"
+	defaultExportManifestFile      = "export_manifest.json"
+	defaultResourceNameMapFile     = "resource_names.json"
+	defaultDriftReportJSONFile     = "drift_report.json"
+	defaultDriftReportMarkdownFile = "drift_report.md"
+	defaultExportSummaryFile       = "export_summary.json"
+	defaultDependencyGraphJSONFile = "dependency_graph.json"
+	defaultDependencyGraphDOTFile  = "dependency_graph.dot"
+	defaultCyclicDependenciesFile  = "cyclic_dependencies.json"
 )
 
+// importBlock is a Terraform 1.5+ import block for an exported resource
+type importBlock struct {
+	ResourceType string
+	ResourceName string
+	Id           string
+
+	// Name of the child module containing the resource when the export is grouped into modules
+	Module string
+}
+
+func (i importBlock) address() string {
+	if i.Module != "" {
+		return "module." + i.Module + "." + i.ResourceType + "." + i.ResourceName
+	}
+	return i.ResourceType + "." + i.ResourceName
+}
+
+// movedBlock is a Terraform 1.1+ moved block for an exported resource whose name changed since the previous export
+type movedBlock struct {
+	ResourceType string
+	From         string
+	To           string
+}
+
+func (m movedBlock) fromAddress() string {
+	return m.ResourceType + "." + m.From
+}
+
+func (m movedBlock) toAddress() string {
+	return m.ResourceType + "." + m.To
+}
+
 // Common Exporter interface to abstract away whether we are using HCL or JSON as our exporter
 type Exporter func() diag.Diagnostics
 type ExporterFilterType int64
@@ -0,0 +1,196 @@
+package tfexporter
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"path/filepath"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+/*
+This file contains the logic for the summary of an export. The summary records the number of objects listed and exported for every
+resource type, how long listing and reading them took, the number of API requests made, the usage of the SDK client pool, the
+objects that were skipped and the attributes that could not be resolved. It is written to the export directory as JSON so that the
+health of exports can be tracked by other tools. While the objects are read, the progress of the export is periodically reported through tflog.
+*/
+
+const (
+	skipReasonNotFound     = "not found"
+	skipReasonStateFilters = "does not match the state filters"
+)
+
+// How often the progress of the export is reported while objects are read
+var exportProgressInterval = 30 * time.Second
+
+type exportSummary struct {
+	StartTime            time.Time                       `json:"start_time"`
+	EndTime              time.Time                       `json:"end_time"`
+	DurationSeconds      float64                         `json:"duration_seconds"`
+	ResourceTypes        map[string]*resourceTypeSummary `json:"resource_types"`
+	APIRequests          provider.APIRequestCounts       `json:"api_requests"`
+	ClientPool           *provider.ClientPoolMetrics     `json:"client_pool,omitempty"`
+	Skipped              []skippedResource               `json:"skipped"`
+	UnresolvedAttributes []unresolvedAttributeSummary    `json:"unresolved_attributes"`
+
+	mutex              sync.Mutex
+	apiRequestsAtStart provider.APIRequestCounts
+	listed             atomic.Int64
+	read               atomic.Int64
+}
+
+type resourceTypeSummary struct {
+	Listed              int     `json:"listed"`
+	Exported            int     `json:"exported"`
+	ListDurationSeconds float64 `json:"list_duration_seconds"`
+	ReadDurationSeconds float64 `json:"read_duration_seconds"`
+}
+
+// skippedResource is an object, or every object of a resource type when the ID is empty, that was left out of the export
+type skippedResource struct {
+	ResourceType string `json:"resource_type"`
+	Id           string `json:"id,omitempty"`
+	Name         string `json:"name,omitempty"`
+	Reason       string `json:"reason"`
+}
+
+type unresolvedAttributeSummary struct {
+	ResourceType string `json:"resource_type"`
+	ResourceName string `json:"resource_name"`
+	Attribute    string `json:"attribute"`
+	Variable     string `json:"variable"`
+}
+
+func newExportSummary() *exportSummary {
+	return &exportSummary{
+		StartTime:          time.Now(),
+		ResourceTypes:      make(map[string]*resourceTypeSummary),
+		Skipped:            make([]skippedResource, 0),
+		apiRequestsAtStart: provider.GetAPIRequestCounts(),
+	}
+}
+
+// resourceType returns the summary of a resource type. The mutex must be held by the caller.
+func (s *exportSummary) resourceType(resType string) *resourceTypeSummary {
+	if s.ResourceTypes[resType] == nil {
+		s.ResourceTypes[resType] = &resourceTypeSummary{}
+	}
+	return s.ResourceTypes[resType]
+}
+
+// The methods recording the export are safe to call on a nil summary, as parts of the export are run without one in tests
+
+func (s *exportSummary) recordListed(resType string, count int, duration time.Duration) {
+	if s == nil {
+		return
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	summary := s.resourceType(resType)
+	summary.Listed += count
+	summary.ListDurationSeconds += duration.Seconds()
+	s.listed.Add(int64(count))
+}
+
+func (s *exportSummary) recordRead(resType string, duration time.Duration) {
+	if s == nil {
+		return
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.resourceType(resType).ReadDurationSeconds += duration.Seconds()
+}
+
+// objectRead counts an object whose state was read for the progress of the export
+func (s *exportSummary) objectRead() {
+	if s == nil {
+		return
+	}
+	s.read.Add(1)
+}
+
+func (s *exportSummary) recordSkipped(skipped skippedResource) {
+	if s == nil {
+		return
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.Skipped = append(s.Skipped, skipped)
+}
+
+// reportProgress periodically logs the number of objects read until the context is done
+func (s *exportSummary) reportProgress(ctx context.Context) {
+	if s == nil {
+		return
+	}
+	ticker := time.NewTicker(exportProgressInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			tflog.Info(ctx, "Export in progress", map[string]interface{}{
+				"objects_read":    s.read.Load(),
+				"objects_listed":  s.listed.Load(),
+				"elapsed_seconds": int(time.Since(s.StartTime).Seconds()),
+			})
+		}
+	}
+}
+
+// complete records the exported objects, unresolved attributes and API requests of the finished export
+func (s *exportSummary) complete(resources []resourceExporter.ResourceInfo, unresolvedAttrs []unresolvableAttributeInfo) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, summary := range s.ResourceTypes {
+		summary.Exported = 0
+	}
+	for _, resource := range resources {
+		s.resourceType(resource.Type).Exported++
+	}
+
+	s.UnresolvedAttributes = make([]unresolvedAttributeSummary, 0, len(unresolvedAttrs))
+	for _, attr := range unresolvedAttrs {
+		s.UnresolvedAttributes = append(s.UnresolvedAttributes, unresolvedAttributeSummary{
+			ResourceType: attr.ResourceType,
+			ResourceName: attr.ResourceName,
+			Attribute:    attr.Name,
+			Variable:     createUnresolvedAttrKey(attr),
+		})
+	}
+
+	sort.Slice(s.Skipped, func(i, j int) bool {
+		if s.Skipped[i].ResourceType != s.Skipped[j].ResourceType {
+			return s.Skipped[i].ResourceType < s.Skipped[j].ResourceType
+		}
+		return s.Skipped[i].Id < s.Skipped[j].Id
+	})
+
+	s.EndTime = time.Now()
+	s.DurationSeconds = s.EndTime.Sub(s.StartTime).Seconds()
+	s.APIRequests = provider.GetAPIRequestCounts().Sub(s.apiRequestsAtStart)
+}
+
+func (s *exportSummary) write(dirPath string) diag.Diagnostics {
+	s.mutex.Lock()
+	data, err := json.MarshalIndent(s, "", "  ")
+	s.mutex.Unlock()
+	if err != nil {
+		return diag.Errorf("Failed to encode export summary as JSON: %v", err)
+	}
+
+	summaryPath := filepath.Join(dirPath, defaultExportSummaryFile)
+	log.Printf("Writing export summary to %s", summaryPath)
+	return files.WriteToFile(data, summaryPath)
+}
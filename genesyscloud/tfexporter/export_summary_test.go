@@ -0,0 +1,66 @@
+package tfexporter
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestUnitExportSummary verifies the export summary records the listed, exported and skipped objects and the unresolved attributes
+func TestUnitExportSummary(t *testing.T) {
+	dir := t.TempDir()
+	summary := newExportSummary()
+
+	summary.recordListed("genesyscloud_routing_queue", 3, 2*time.Second)
+	summary.recordListed("genesyscloud_user", 0, time.Second)
+	summary.recordRead("genesyscloud_routing_queue", 4*time.Second)
+	summary.recordSkipped(skippedResource{ResourceType: "genesyscloud_user", Reason: "API Error: 403 - Missing permissions"})
+	summary.recordSkipped(skippedResource{ResourceType: "genesyscloud_routing_queue", Id: "queue-3", Name: "Deleted", Reason: skipReasonNotFound})
+
+	resources := []resourceExporter.ResourceInfo{
+		{Type: "genesyscloud_routing_queue", Name: "Sales"},
+		{Type: "genesyscloud_routing_queue", Name: "Support"},
+	}
+	unresolvedAttrs := []unresolvableAttributeInfo{
+		{ResourceType: "genesyscloud_routing_queue", ResourceName: "Sales", Name: "outbound_email_address", Schema: &schema.Schema{Type: schema.TypeString}},
+	}
+	summary.complete(resources, unresolvedAttrs)
+	if diagErr := summary.write(dir); diagErr != nil {
+		t.Fatalf("failed to write export summary: %v", diagErr)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, defaultExportSummaryFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	written := &exportSummary{}
+	if err := json.Unmarshal(data, written); err != nil {
+		t.Fatal(err)
+	}
+
+	queues := written.ResourceTypes["genesyscloud_routing_queue"]
+	assert.Equal(t, 3, queues.Listed)
+	assert.Equal(t, 2, queues.Exported)
+	assert.Equal(t, 2.0, queues.ListDurationSeconds)
+	assert.Equal(t, 4.0, queues.ReadDurationSeconds)
+	assert.Equal(t, 0, written.ResourceTypes["genesyscloud_user"].Exported)
+
+	assert.Equal(t, []skippedResource{
+		{ResourceType: "genesyscloud_routing_queue", Id: "queue-3", Name: "Deleted", Reason: skipReasonNotFound},
+		{ResourceType: "genesyscloud_user", Reason: "API Error: 403 - Missing permissions"},
+	}, written.Skipped)
+	assert.Equal(t, []unresolvedAttributeSummary{{
+		ResourceType: "genesyscloud_routing_queue",
+		ResourceName: "Sales",
+		Attribute:    "outbound_email_address",
+		Variable:     createUnresolvedAttrKey(unresolvedAttrs[0]),
+	}}, written.UnresolvedAttributes)
+	assert.False(t, written.EndTime.Before(written.StartTime))
+}
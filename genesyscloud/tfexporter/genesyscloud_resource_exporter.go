@@ -12,6 +12,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -28,6 +29,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -69,12 +71,16 @@ type GenesysCloudResourceExporter struct {
 	resourceTypeFilter     ExporterResourceTypeFilter
 	resourceFilter         ExporterResourceFilter
 	filterList             *[]string
+	stateFilters           []*stateFilter
 	exportAsHCL            bool
 	splitFilesByResource   bool
 	logPermissionErrors    bool
 	addDependsOn           bool
 	replaceWithDatasource  []string
 	includeStateFile       bool
+	exportImportBlocks     bool
+	importBlocks           []importBlock
+	moduleGrouping         string
 	version                string
 	provider               *schema.Provider
 	exportDirPath          string
@@ -92,7 +98,22 @@ type GenesysCloudResourceExporter struct {
 	exMutex                sync.RWMutex
 	cyclicDependsList      []string
 	ignoreCyclicDeps       bool
+	breakCyclicDeps        bool
+	removedDependsOn       map[string]map[string]bool
+	dependencyCycles       []*dependencyCycle
 	flowResourcesList      []string
+	incremental            bool
+	warnings               diag.Diagnostics
+	previousManifest       *exportManifest
+	manifest               *exportManifest
+	stableResourceNames    bool
+	exportMovedBlocks      bool
+	previousNameMap        *resourceNameMap
+	maxConcurrentReads     int
+	maxConcurrentReadsType map[string]int
+	maxRequestsPerSecond   int
+	summary                *exportSummary
+	exportDependencyGraph  bool
 }
 
 func configureExporterType(ctx context.Context, d *schema.ResourceData, gre *GenesysCloudResourceExporter, filterType ExporterFilterType) {
@@ -137,18 +158,26 @@ func NewGenesysCloudResourceExporter(ctx context.Context, d *schema.ResourceData
 	}
 
 	gre := &GenesysCloudResourceExporter{
-		exportAsHCL:          d.Get("export_as_hcl").(bool),
-		splitFilesByResource: d.Get("split_files_by_resource").(bool),
-		logPermissionErrors:  d.Get("log_permission_errors").(bool),
-		addDependsOn:         computeDependsOn(d),
-		filterType:           filterType,
-		includeStateFile:     d.Get("include_state_file").(bool),
-		ignoreCyclicDeps:     d.Get("ignore_cyclic_deps").(bool),
-		version:              meta.(*provider.ProviderMeta).Version,
-		provider:             provider.New(meta.(*provider.ProviderMeta).Version, providerResources, providerDataSources)(),
-		d:                    d,
-		ctx:                  ctx,
-		meta:                 meta,
+		exportAsHCL:           d.Get("export_as_hcl").(bool),
+		splitFilesByResource:  d.Get("split_files_by_resource").(bool),
+		logPermissionErrors:   d.Get("log_permission_errors").(bool),
+		addDependsOn:          computeDependsOn(d),
+		filterType:            filterType,
+		includeStateFile:      d.Get("include_state_file").(bool),
+		exportImportBlocks:    d.Get("export_import_blocks").(bool),
+		moduleGrouping:        d.Get("module_grouping").(string),
+		ignoreCyclicDeps:      d.Get("ignore_cyclic_deps").(bool),
+		incremental:           d.Get("incremental").(bool),
+		stableResourceNames:   d.Get("stable_resource_names").(bool),
+		exportMovedBlocks:     d.Get("export_moved_blocks").(bool),
+		exportDependencyGraph: d.Get("export_dependency_graph").(bool),
+		maxConcurrentReads:    d.Get("max_concurrent_reads").(int),
+		maxRequestsPerSecond:  d.Get("max_requests_per_second").(int),
+		version:               meta.(*provider.ProviderMeta).Version,
+		provider:              provider.New(meta.(*provider.ProviderMeta).Version, providerResources, providerDataSources)(),
+		d:                     d,
+		ctx:                   ctx,
+		meta:                  meta,
 	}
 
 	err := gre.setUpExportDirPath()
@@ -156,10 +185,32 @@ func NewGenesysCloudResourceExporter(ctx context.Context, d *schema.ResourceData
 		return nil, err
 	}
 
+	if gre.incremental {
+		gre.previousManifest = readExportManifest(gre.exportDirPath, gre.version)
+		gre.manifest = newExportManifest(gre.version)
+	}
+
+	gre.maxConcurrentReadsType = make(map[string]int)
+	for resType, concurrency := range d.Get("max_concurrent_reads_by_type").(map[string]interface{}) {
+		gre.maxConcurrentReadsType[resType] = concurrency.(int)
+	}
+
+	if gre.stableResourceNames || gre.exportMovedBlocks {
+		gre.previousNameMap = readResourceNameMap(gre.exportDirPath)
+	}
+
 	gre.setupDataSource()
 
 	//Setting up the filter
 	configureExporterType(ctx, d, gre, filterType)
+
+	if stateFilters, ok := d.GetOk("state_filters"); ok {
+		filters, err := parseStateFilters(lists.InterfaceListToStrings(stateFilters.([]interface{})))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		gre.stateFilters = filters
+	}
 	return gre, nil
 }
 
@@ -177,6 +228,10 @@ func computeDependsOn(d *schema.ResourceData) bool {
 }
 
 func (g *GenesysCloudResourceExporter) Export() (diagErr diag.Diagnostics) {
+	g.summary = newExportSummary()
+	// Only the requests of the export are throttled, and paused by Retry-After headers
+	g.meta = provider.WithRequestLimit(g.meta, g.maxRequestsPerSecond)
+
 	// Step #1 Retrieve the exporters we are have registered and have been requested by the user
 	diagErr = g.retrieveExporters()
 	if diagErr != nil {
@@ -194,34 +249,41 @@ func (g *GenesysCloudResourceExporter) Export() (diagErr diag.Diagnostics) {
 		return diagErr
 	}
 
-	// Step #4 export dependent resources for the flows
+	// Step #4 Remove the objects that do not match the state filters
+	diagErr = g.applyStateFilters()
+	if diagErr.HasError() {
+		return diagErr
+	}
+	g.warnings = append(g.warnings, diagErr...)
+
+	// Step #5 export dependent resources for the flows
 	diagErr = g.buildAndExportDependsOnResourcesForFlows()
 	if diagErr != nil {
 		return diagErr
 	}
 
-	// Step #5 Convert the Genesys Cloud resources to neutral format (e.g. map of maps)
+	// Step #6 Convert the Genesys Cloud resources to neutral format (e.g. map of maps)
 	diagErr = g.buildResourceConfigMap()
 	if diagErr != nil {
 		return diagErr
 	}
 
-	// Step #6 export dependents for other resources
+	// Step #7 export dependents for other resources
 	diagErr = g.buildAndExportDependentResources()
 	if diagErr != nil {
 		return diagErr
 	}
 
-	// Step #7 Write the terraform state file along with either the HCL or JSON
+	// Step #8 Write the terraform state file along with either the HCL or JSON
 	diagErr = g.generateOutputFiles()
 	if diagErr != nil {
 		return diagErr
 	}
 
-	// step #8 Verify the terraform state file with Exporter Resources
+	// step #9 Verify the terraform state file with Exporter Resources
 	g.verifyTerraformState()
 
-	return nil
+	return g.warnings
 }
 
 func (g *GenesysCloudResourceExporter) setUpExportDirPath() (diagErr diag.Diagnostics) {
@@ -249,6 +311,9 @@ func (g *GenesysCloudResourceExporter) setupDataSource() {
 func (g *GenesysCloudResourceExporter) retrieveExporters() (diagErr diag.Diagnostics) {
 	log.Printf("Retrieving exporters list")
 	exports := resourceExporter.GetResourceExporters()
+	if diagErr := g.validateConcurrentReadsTypes(exports); diagErr != nil {
+		return diagErr
+	}
 
 	log.Printf("Retrieving exporters list %v", g.filterList)
 
@@ -325,6 +390,8 @@ func (g *GenesysCloudResourceExporter) retrieveGenesysCloudObjectInstances() dia
 
 	ctx, cancel := context.WithCancel(g.ctx)
 	defer cancel()
+	go g.summary.reportProgress(ctx)
+
 	// We use concurrency here to spin off each exporter type and getting the data
 	for resType, exporter := range *g.exporters {
 		wg.Add(1)
@@ -332,7 +399,9 @@ func (g *GenesysCloudResourceExporter) retrieveGenesysCloudObjectInstances() dia
 			defer wg.Done()
 
 			log.Printf("Getting exported resources for [%s]", resType)
+			start := time.Now()
 			typeResources, err := g.getResourcesForType(resType, g.provider, exporter, g.meta)
+			g.summary.recordRead(resType, time.Since(start))
 
 			if err != nil {
 				select {
@@ -342,6 +411,7 @@ func (g *GenesysCloudResourceExporter) retrieveGenesysCloudObjectInstances() dia
 				cancel()
 				return
 			}
+			tflog.Info(ctx, "Finished reading exported resources", map[string]interface{}{"resource_type": resType, "count": len(typeResources)})
 			g.resources = append(g.resources, typeResources...)
 		}(resType, exporter)
 	}
@@ -368,6 +438,7 @@ func (g *GenesysCloudResourceExporter) buildResourceConfigMap() diag.Diagnostics
 	g.dataSourceTypesMaps = make(map[string]resourceJSONMaps)
 	g.resourceTypesHCLBlocks = make(map[string]resourceHCLBlock, 0)
 	g.unresolvedAttrs = make([]unresolvableAttributeInfo, 0)
+	g.importBlocks = nil
 
 	for _, resource := range g.resources {
 		jsonResult, diagErr := g.instanceStateToMap(resource.State, resource.CtyType)
@@ -381,15 +452,27 @@ func (g *GenesysCloudResourceExporter) buildResourceConfigMap() diag.Diagnostics
 		}
 
 		if len(g.resourceTypesMaps[resource.Type][resource.Name]) > 0 || len(g.dataSourceTypesMaps[resource.Type][resource.Name]) > 0 {
+			suffix := uuid.NewString()
+			if g.stableResourceNames {
+				suffix = resource.State.ID
+			}
 			algorithm := fnv.New32()
-			algorithm.Write([]byte(uuid.NewString()))
+			algorithm.Write([]byte(suffix))
 			resource.Name = resource.Name + "_" + strconv.FormatUint(uint64(algorithm.Sum32()), 10)
 			g.updateSanitiseMap(*g.exporters, resource)
 		}
 
+		if g.exportImportBlocks && !isDataSource {
+			g.importBlocks = append(g.importBlocks, importBlock{
+				ResourceType: resource.Type,
+				ResourceName: resource.Name,
+				Id:           resource.State.ID,
+			})
+		}
+
 		if !isDataSource {
 			// Removes zero values and sets proper reference expressions
-			unresolved, _ := g.sanitizeConfigMap(resource.Type, resource.Name, jsonResult, "", *g.exporters, g.includeStateFile, g.exportAsHCL, true)
+			unresolved, _ := g.sanitizeConfigMap(resource.Type, resource.Name, jsonResult, "", *g.exporters, g.includeStateFile || g.exportImportBlocks, g.exportAsHCL, true)
 			if len(unresolved) > 0 {
 				g.unresolvedAttrs = append(g.unresolvedAttrs, unresolved...)
 			}
@@ -410,7 +493,7 @@ func (g *GenesysCloudResourceExporter) buildResourceConfigMap() diag.Diagnostics
 			if _, ok := g.resourceTypesHCLBlocks[resource.Type]; !ok {
 				g.resourceTypesHCLBlocks[resource.Type] = make(resourceHCLBlock, 0)
 			}
-			g.resourceTypesHCLBlocks[resource.Type] = append(g.resourceTypesHCLBlocks[resource.Type], instanceStateToHCLBlock(resource.Type, resource.Name, jsonResult, isDataSource))
+			g.resourceTypesHCLBlocks[resource.Type] = append(g.resourceTypesHCLBlocks[resource.Type], instanceStateToHCLBlock(resource.Type, resource.Name, jsonResult, isDataSource, g.incremental))
 		}
 
 		if isDataSource {
@@ -440,17 +523,19 @@ func (g *GenesysCloudResourceExporter) updateSanitiseMap(exporters map[string]*r
 }
 
 func (g *GenesysCloudResourceExporter) instanceStateToMap(state *terraform.InstanceState, ctyType cty.Type) (util.JsonMap, diag.Diagnostics) {
-	stateVal, err := schema.StateValueFromInstanceState(state, ctyType)
+	jsonMap, err := instanceStateToJsonMap(state, ctyType)
 	if err != nil {
 		return nil, diag.FromErr(err)
 	}
+	return jsonMap, nil
+}
 
-	jsonMap, err := schema.StateValueToJSONMap(stateVal, ctyType)
-
+func instanceStateToJsonMap(state *terraform.InstanceState, ctyType cty.Type) (util.JsonMap, error) {
+	stateVal, err := schema.StateValueFromInstanceState(state, ctyType)
 	if err != nil {
-		return nil, diag.FromErr(err)
+		return nil, err
 	}
-	return jsonMap, nil
+	return schema.StateValueToJSONMap(stateVal, ctyType)
 }
 
 // generateOutputFiles is used to generate the tfStateFile and either the tf export or the json based export
@@ -463,12 +548,26 @@ func (g *GenesysCloudResourceExporter) generateOutputFiles() diag.Diagnostics {
 		}
 	}
 
+	sort.Slice(g.importBlocks, func(i, j int) bool {
+		return g.importBlocks[i].address() < g.importBlocks[j].address()
+	})
+
+	var movedBlocks []movedBlock
+	if g.exportMovedBlocks {
+		var movedWarnings diag.Diagnostics
+		movedBlocks, movedWarnings = g.buildMovedBlocks()
+		g.warnings = append(g.warnings, movedWarnings...)
+	}
+
 	var err diag.Diagnostics
-	if g.exportAsHCL {
-		hclExporter := NewHClExporter(g.resourceTypesHCLBlocks, g.unresolvedAttrs, providerSource, g.version, g.exportDirPath, g.splitFilesByResource)
+	if g.moduleGrouping != "" {
+		moduleExporter := NewModuleExporter(g.buildExportModules(), g.unresolvedAttrs, g.importBlocks, providerSource, g.version, g.exportDirPath, g.exportAsHCL)
+		err = moduleExporter.exportModules()
+	} else if g.exportAsHCL {
+		hclExporter := NewHClExporter(g.resourceTypesHCLBlocks, g.unresolvedAttrs, g.importBlocks, movedBlocks, providerSource, g.version, g.exportDirPath, g.splitFilesByResource, g.incremental)
 		err = hclExporter.exportHCLConfig()
 	} else {
-		jsonExporter := NewJsonExporter(g.resourceTypesMaps, g.dataSourceTypesMaps, g.unresolvedAttrs, providerSource, g.version, g.exportDirPath, g.splitFilesByResource)
+		jsonExporter := NewJsonExporter(g.resourceTypesMaps, g.dataSourceTypesMaps, g.unresolvedAttrs, g.importBlocks, movedBlocks, providerSource, g.version, g.exportDirPath, g.splitFilesByResource, g.incremental)
 		err = jsonExporter.exportJSONConfig()
 	}
 
@@ -484,6 +583,43 @@ func (g *GenesysCloudResourceExporter) generateOutputFiles() diag.Diagnostics {
 		}
 	}
 
+	if len(g.dependencyCycles) > 0 {
+		err = g.buildCyclicDependenciesReport().write(g.exportDirPath)
+		if err != nil {
+			return err
+		}
+	}
+
+	if g.incremental {
+		err = g.manifest.write(g.exportDirPath)
+		if err != nil {
+			return err
+		}
+	}
+
+	if g.stableResourceNames || g.exportMovedBlocks {
+		err = buildResourceNameMap(*g.exporters).write(g.exportDirPath)
+		if err != nil {
+			return err
+		}
+	}
+
+	if g.summary != nil {
+		g.summary.ClientPool = provider.GetClientPoolMetrics(g.meta)
+		g.summary.complete(g.resources, g.unresolvedAttrs)
+		err = g.summary.write(g.exportDirPath)
+		if err != nil {
+			return err
+		}
+	}
+
+	if g.exportDependencyGraph {
+		err = newDependencyGraph(g.resources, *g.exporters, g.dependsList, g.isDataSource).write(g.exportDirPath)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = g.generateZipForExporter()
 	if err != nil {
 		return err
@@ -582,7 +718,7 @@ func (g *GenesysCloudResourceExporter) processAndBuildDependencies() (filters []
 			continue
 		}
 
-		resources, dependsStruct, err := proxy.GetAllWithPooledClient(retrieveDependentConsumers(resourceKeys))
+		resources, dependsStruct, err := proxy.GetAllWithPooledClient(provider.WithClientPool(g.ctx, g.meta), retrieveDependentConsumers(resourceKeys))
 
 		g.flowResourcesList = append(g.flowResourcesList, resourceKeys.State.ID)
 
@@ -603,9 +739,12 @@ func (g *GenesysCloudResourceExporter) processAndBuildDependencies() (filters []
 		}
 	}
 
-	if !g.ignoreCyclicDeps && len(g.cyclicDependsList) > 0 {
+	if !g.ignoreCyclicDeps && !g.breakCyclicDeps && len(g.cyclicDependsList) > 0 {
 		return nil, nil, diag.Errorf("Cyclic Dependencies Identified:  %v ", strings.Join(g.cyclicDependsList, "\n"))
 	}
+	if g.breakCyclicDeps {
+		g.breakDependencyCycles()
+	}
 	return filterList, totalResources, nil
 }
 
@@ -871,7 +1010,7 @@ func (g *GenesysCloudResourceExporter) buildSanitizedResourceMaps(exporters map[
 	errorChan := make(chan diag.Diagnostics)
 	wgDone := make(chan bool)
 	// Cancel remaining goroutines if an error occurs
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(provider.WithClientPool(context.Background(), g.meta))
 	defer cancel()
 
 	var wg sync.WaitGroup
@@ -882,7 +1021,8 @@ func (g *GenesysCloudResourceExporter) buildSanitizedResourceMaps(exporters map[
 			log.Printf("Getting all resources for type %s", name)
 			exporter.FilterResource = g.resourceFilter
 
-			err := exporter.LoadSanitizedResourceMap(ctx, name, filter)
+			start := time.Now()
+			err := exporter.LoadSanitizedResourceMap(provider.WithAPITelemetryLabel(ctx, name, provider.OperationList), name, filter)
 
 			// Used in tests
 			if mockError != nil {
@@ -891,6 +1031,7 @@ func (g *GenesysCloudResourceExporter) buildSanitizedResourceMaps(exporters map[
 			if containsPermissionsErrorOnly(err) && logErrors {
 				log.Printf("%v", err[0].Summary)
 				log.Printf("Logging permission error for %s. Resuming export...", name)
+				g.summary.recordSkipped(skippedResource{ResourceType: name, Reason: err[0].Summary})
 				return
 			}
 			if err != nil {
@@ -904,7 +1045,11 @@ func (g *GenesysCloudResourceExporter) buildSanitizedResourceMaps(exporters map[
 				cancel()
 				return
 			}
+			if g.stableResourceNames {
+				g.previousNameMap.assignNames(name, exporter.SanitizedResourceMap)
+			}
 			log.Printf("Found %d resources for type %s", len(exporter.SanitizedResourceMap), name)
+			g.summary.recordListed(name, len(exporter.SanitizedResourceMap), time.Since(start))
 		}(name, exporter)
 	}
 
@@ -994,6 +1139,62 @@ func addLogAttrInfoToErrorSummary(err diag.Diagnostics) diag.Diagnostics {
 	return err
 }
 
+// readConcurrency returns the maximum number of objects of a resource type that are read concurrently. Zero means the reads are
+// only limited by the size of the SDK client pool.
+func (g *GenesysCloudResourceExporter) readConcurrency(resType string) int {
+	if concurrency, ok := g.maxConcurrentReadsType[resType]; ok {
+		return concurrency
+	}
+	return g.maxConcurrentReads
+}
+
+// validateConcurrentReadsByType is the schema validation function for max_concurrent_reads_by_type. The resource types are checked
+// against the registered exporters when the export runs.
+func validateConcurrentReadsByType(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(map[string]interface{})
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be map", k)}
+	}
+	for resType, value := range v {
+		if !strings.HasPrefix(resType, "genesyscloud_") {
+			errors = append(errors, fmt.Errorf("%s key %s is not a resource type", k, resType))
+		}
+		concurrency, err := concurrentReadsValue(value)
+		if err != nil {
+			errors = append(errors, fmt.Errorf("%s value of %s is not a number: %v", k, resType, err))
+		} else if concurrency < 0 {
+			errors = append(errors, fmt.Errorf("%s value of %s must be at least 0, got %d", k, resType, concurrency))
+		}
+	}
+	return warnings, errors
+}
+
+// concurrentReadsValue returns a value of max_concurrent_reads_by_type, which is a string when it is validated from a config
+func concurrentReadsValue(value interface{}) (int, error) {
+	switch v := value.(type) {
+	case int:
+		return v, nil
+	case string:
+		return strconv.Atoi(v)
+	}
+	return 0, fmt.Errorf("unexpected type %T", value)
+}
+
+// validateConcurrentReadsTypes returns an error for the resource types of max_concurrent_reads_by_type that have no exporter
+func (g *GenesysCloudResourceExporter) validateConcurrentReadsTypes(exporters map[string]*resourceExporter.ResourceExporter) diag.Diagnostics {
+	unknown := make([]string, 0)
+	for resType := range g.maxConcurrentReadsType {
+		if _, ok := exporters[resType]; !ok {
+			unknown = append(unknown, resType)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return diag.Errorf("max_concurrent_reads_by_type contains resource types that cannot be exported: %s", strings.Join(unknown, ", "))
+	}
+	return nil
+}
+
 func (g *GenesysCloudResourceExporter) getResourcesForType(resType string, provider *schema.Provider, exporter *resourceExporter.ResourceExporter, meta interface{}) ([]resourceExporter.ResourceInfo, diag.Diagnostics) {
 	lenResources := len(exporter.SanitizedResourceMap)
 	errorChan := make(chan diag.Diagnostics, lenResources)
@@ -1006,18 +1207,28 @@ func (g *GenesysCloudResourceExporter) getResourcesForType(resType string, provi
 		return nil, diag.Errorf("Resource type %v not defined", resType)
 	}
 
+	// Limit the number of objects read concurrently when a concurrency is configured for the resource type
+	var readSlots chan struct{}
+	if concurrency := g.readConcurrency(resType); concurrency > 0 {
+		readSlots = make(chan struct{}, concurrency)
+	}
+
 	ctyType := res.CoreConfigSchema().ImpliedType()
 	var wg sync.WaitGroup
 	wg.Add(lenResources)
 	for id, resMeta := range exporter.SanitizedResourceMap {
 		go func(id string, resMeta *resourceExporter.ResourceMeta) {
 			defer wg.Done()
+			if readSlots != nil {
+				readSlots <- struct{}{}
+				defer func() { <-readSlots }()
+			}
 			fetchResourceState := func() error {
-				ctx, cancel := context.WithTimeout(context.Background(), time.Duration(30)*time.Minute)
+				ctx, cancel := readResourceContext(resType)
 				defer cancel()
 				// This calls into the resource's ReadContext method which
 				// will block until it can acquire a pooled client config object.
-				instanceState, err := getResourceState(ctx, res, id, resMeta, meta)
+				instanceState, err := g.resourceState(ctx, res, resType, id, resMeta, meta)
 
 				resourceType := ""
 				if g.isDataSource(resType, resMeta.Name) {
@@ -1047,8 +1258,10 @@ func (g *GenesysCloudResourceExporter) getResourcesForType(resType string, provi
 					return fmt.Errorf(errString)
 				}
 
+				g.summary.objectRead()
 				if instanceState == nil {
 					log.Printf("Resource %s no longer exists. Skipping.", resMeta.Name)
+					g.summary.recordSkipped(skippedResource{ResourceType: resType, Id: id, Name: resMeta.Name, Reason: skipReasonNotFound})
 					removeChan <- id // Mark for removal from the map
 					return nil
 				}
@@ -1108,6 +1321,11 @@ func (g *GenesysCloudResourceExporter) getResourcesForType(resType string, provi
 	}
 }
 
+// readResourceContext returns the context that the state of an object of a resource type is read with
+func readResourceContext(resType string) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(provider.WithAPITelemetryLabel(context.Background(), resType, provider.OperationRead), time.Duration(30)*time.Minute)
+}
+
 func getResourceState(ctx context.Context, resource *schema.Resource, resID string, resMeta *resourceExporter.ResourceMeta, meta interface{}) (*terraform.InstanceState, diag.Diagnostics) {
 	// If defined, pass the full ID through the import method to generate a readable state
 	instanceState := &terraform.InstanceState{ID: resMeta.IdPrefix + resID}
@@ -1121,6 +1339,11 @@ func getResourceState(ctx context.Context, resource *schema.Resource, resID stri
 		}
 	}
 
+	return refreshResourceState(ctx, resource, instanceState, meta)
+}
+
+// refreshResourceState calls into the resource's ReadContext method. A nil state is returned if the resource no longer exists.
+func refreshResourceState(ctx context.Context, resource *schema.Resource, instanceState *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, diag.Diagnostics) {
 	state, err := resource.RefreshWithoutUpgrade(ctx, instanceState, meta)
 	if err != nil {
 		if strings.Contains(fmt.Sprintf("%v", err), "API Error: 404") ||
@@ -1403,7 +1626,7 @@ func (g *GenesysCloudResourceExporter) resolveValueToDataSource(exporter *resour
 		if _, ok := g.resourceTypesHCLBlocks[dataSourceType]; !ok {
 			g.resourceTypesHCLBlocks[dataSourceType] = make(resourceHCLBlock, 0)
 		}
-		g.resourceTypesHCLBlocks[dataSourceType] = append(g.resourceTypesHCLBlocks[dataSourceType], instanceStateToHCLBlock(dataSourceType, dataSourceId, dataSourceConfig, true))
+		g.resourceTypesHCLBlocks[dataSourceType] = append(g.resourceTypesHCLBlocks[dataSourceType], instanceStateToHCLBlock(dataSourceType, dataSourceId, dataSourceConfig, true, g.incremental))
 	}
 }
 
@@ -1432,6 +1655,9 @@ func (g *GenesysCloudResourceExporter) addDependsOnValues(key string, configMap
 	resourceDependsList := make([]string, 0)
 	if exists {
 		for _, res := range list {
+			if g.isDependsOnRemoved(key, res) {
+				continue
+			}
 			for _, resource := range g.resources {
 				if resource.State.ID == strings.Split(res, ".")[1] {
 					resourceDependsList = append(resourceDependsList, fmt.Sprintf("$dep$%s$dep$", strings.Split(res, ".")[0]+"."+resource.Name))
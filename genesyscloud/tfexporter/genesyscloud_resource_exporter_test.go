@@ -323,7 +323,7 @@ func TestUnitTfExportBuildDependsOnResources(t *testing.T) {
 		return resources, dependencyStruct, nil
 	}
 
-	getAllPooledFn := func(method provider.GetCustomConfigFunc) (resourceExporter.ResourceIDMetaMap, *resourceExporter.DependencyResource, diag.Diagnostics) {
+	getAllPooledFn := func(ctx context.Context, method provider.GetCustomConfigFunc) (resourceExporter.ResourceIDMetaMap, *resourceExporter.DependencyResource, diag.Diagnostics) {
 		//assert.Equal(t, targetName, name)
 		return resources, dependencyStruct, nil
 	}
@@ -579,3 +579,57 @@ func getMockCampaignConfig(originalValueOfScriptId string) map[string]any {
 
 	return config
 }
+
+// TestUnitTfExportImportBlocks verifies import blocks are built for exported resources but not for data sources
+func TestUnitTfExportImportBlocks(t *testing.T) {
+	testResourceType := "test_import_blocks_resource"
+	testResource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {Type: schema.TypeString},
+		},
+	}
+	ctyType := testResource.CoreConfigSchema().ImpliedType()
+
+	testResourceExporter := GenesysCloudResourceExporter{
+		exportAsHCL:           true,
+		exportImportBlocks:    true,
+		replaceWithDatasource: []string{testResourceType + "::data_res"},
+		exporters: &map[string]*resourceExporter.ResourceExporter{
+			testResourceType: {},
+		},
+		resources: []resourceExporter.ResourceInfo{
+			{Name: "res_b", Type: testResourceType, CtyType: ctyType, State: &terraform.InstanceState{ID: "id-b", Attributes: map[string]string{"name": "b"}}},
+			{Name: "res_a", Type: testResourceType, CtyType: ctyType, State: &terraform.InstanceState{ID: "id-a", Attributes: map[string]string{"name": "a"}}},
+			{Name: "data_res", Type: testResourceType, CtyType: ctyType, State: &terraform.InstanceState{ID: "id-c", Attributes: map[string]string{"name": "c"}}},
+		},
+	}
+
+	if diagErr := testResourceExporter.buildResourceConfigMap(); diagErr != nil {
+		t.Fatalf("failure: %v", diagErr)
+	}
+	assert.Len(t, testResourceExporter.importBlocks, 2)
+
+	hclBlocks := string(createHCLImportBlocks([]importBlock{{ResourceType: testResourceType, ResourceName: "res_a", Id: "id-a"}}))
+	assert.Contains(t, hclBlocks, "to = "+testResourceType+".res_a")
+	assert.Contains(t, hclBlocks, `id = "id-a"`)
+
+	jsonImports := createImportsJsonList(testResourceExporter.importBlocks)
+	assert.Len(t, jsonImports, 2)
+	for _, i := range jsonImports {
+		assert.Contains(t, []string{testResourceType + ".res_a", testResourceType + ".res_b"}, i["to"])
+	}
+}
+
+// TestUnitTfExportConcurrentReadsTypes verifies max_concurrent_reads_by_type only accepts the resource types that can be exported
+func TestUnitTfExportConcurrentReadsTypes(t *testing.T) {
+	exporters := map[string]*resourceExporter.ResourceExporter{"genesyscloud_flow": {}}
+
+	g := GenesysCloudResourceExporter{maxConcurrentReadsType: map[string]int{"genesyscloud_flow": 2}}
+	assert.Nil(t, g.validateConcurrentReadsTypes(exporters))
+
+	g.maxConcurrentReadsType["genesyscloud_not_a_resource"] = 1
+	diagErr := g.validateConcurrentReadsTypes(exporters)
+	if assert.True(t, diagErr.HasError()) {
+		assert.Contains(t, diagErr[0].Summary, "genesyscloud_not_a_resource")
+	}
+}
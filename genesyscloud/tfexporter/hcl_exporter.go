@@ -1,12 +1,16 @@
 package tfexporter
 
 import (
+	"bytes"
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
 
+	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hclwrite"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	zclconfCty "github.com/zclconf/go-cty/cty"
@@ -23,20 +27,26 @@ type resourceHCLBlock [][]byte
 type HCLExporter struct {
 	resourceTypesHCLBlocks map[string]resourceHCLBlock
 	unresolvedAttrs        []unresolvableAttributeInfo
+	importBlocks           []importBlock
+	movedBlocks            []movedBlock
 	providerSource         string
 	version                string
 	dirPath                string
 	splitFilesByResource   bool
+	incremental            bool
 }
 
-func NewHClExporter(resourceTypesHCLBlocks map[string]resourceHCLBlock, unresolvedAttrs []unresolvableAttributeInfo, providerSource string, version string, dirPath string, splitFilesByResource bool) *HCLExporter {
+func NewHClExporter(resourceTypesHCLBlocks map[string]resourceHCLBlock, unresolvedAttrs []unresolvableAttributeInfo, importBlocks []importBlock, movedBlocks []movedBlock, providerSource string, version string, dirPath string, splitFilesByResource bool, incremental bool) *HCLExporter {
 	hclExporter := &HCLExporter{
 		resourceTypesHCLBlocks: resourceTypesHCLBlocks,
 		unresolvedAttrs:        unresolvedAttrs,
+		importBlocks:           importBlocks,
+		movedBlocks:            movedBlocks,
 		providerSource:         providerSource,
 		version:                version,
 		dirPath:                dirPath,
 		splitFilesByResource:   splitFilesByResource,
+		incremental:            incremental,
 	}
 	return hclExporter
 }
@@ -45,13 +55,27 @@ func (h *HCLExporter) exportHCLConfig() diag.Diagnostics {
 	providerBlock := createHCLProviderBlock(h.providerSource, h.version)
 	variablesBlock := createHCLVariablesBlock(h.unresolvedAttrs)
 
+	resTypes := make([]string, 0, len(h.resourceTypesHCLBlocks))
+	for resType, resBlock := range h.resourceTypesHCLBlocks {
+		resTypes = append(resTypes, resType)
+		if h.incremental {
+			// Blocks are retrieved concurrently so sort them to keep unchanged files of incremental exports untouched
+			sort.Slice(resBlock, func(i, j int) bool {
+				return bytes.Compare(resBlock[i], resBlock[j]) < 0
+			})
+		}
+	}
+	if h.incremental {
+		sort.Strings(resTypes)
+	}
+
 	if h.splitFilesByResource {
 		// Provider file
 		providerHCLFilePath := filepath.Join(h.dirPath, defaultTfHCLProviderFile)
 		if providerHCLFilePath == "" {
 			return diag.Errorf("Failed to create file path %s", providerHCLFilePath)
 		}
-		if diagErr := writeHCLToFile([][]byte{providerBlock}, providerHCLFilePath); diagErr != nil {
+		if diagErr := h.writeHCLFile([][]byte{providerBlock}, providerHCLFilePath); diagErr != nil {
 			return diagErr
 		}
 
@@ -60,17 +84,34 @@ func (h *HCLExporter) exportHCLConfig() diag.Diagnostics {
 		if variablesHCLFilePath == "" {
 			return diag.Errorf("Failed to create file path %s", variablesHCLFilePath)
 		}
-		if diagErr := writeHCLToFile([][]byte{variablesBlock}, variablesHCLFilePath); diagErr != nil {
+		if diagErr := h.writeHCLFile([][]byte{variablesBlock}, variablesHCLFilePath); diagErr != nil {
 			return diagErr
 		}
 
+		// Import file
+		if len(h.importBlocks) > 0 {
+			importsHCLFilePath := filepath.Join(h.dirPath, defaultTfHCLImportsFile)
+			if diagErr := h.writeHCLFile([][]byte{createHCLImportBlocks(h.importBlocks)}, importsHCLFilePath); diagErr != nil {
+				return diagErr
+			}
+		}
+
+		// Moved file
+		if len(h.movedBlocks) > 0 {
+			movedHCLFilePath := filepath.Join(h.dirPath, defaultTfHCLMovedFile)
+			if diagErr := h.writeHCLFile([][]byte{createHCLMovedBlocks(h.movedBlocks)}, movedHCLFilePath); diagErr != nil {
+				return diagErr
+			}
+		}
+
 		// Resource files
-		for resType, resBlock := range h.resourceTypesHCLBlocks {
+		for _, resType := range resTypes {
+			resBlock := h.resourceTypesHCLBlocks[resType]
 			resourceHCLFilePath := filepath.Join(h.dirPath, fmt.Sprintf("%s.%s", resType, resourceHCLFileExt))
 			if resourceHCLFilePath == "" {
 				return diag.Errorf("Failed to create file path %s", resourceHCLFilePath)
 			}
-			if diagErr := writeHCLToFile(resBlock, resourceHCLFilePath); diagErr != nil {
+			if diagErr := h.writeHCLFile(resBlock, resourceHCLFilePath); diagErr != nil {
 				return diagErr
 			}
 		}
@@ -79,16 +120,22 @@ func (h *HCLExporter) exportHCLConfig() diag.Diagnostics {
 		allBlockSlice := make([][]byte, 0)
 		allBlockSlice = append(allBlockSlice, providerBlock)
 
-		for _, resBlock := range h.resourceTypesHCLBlocks {
-			allBlockSlice = append(allBlockSlice, resBlock...)
+		for _, resType := range resTypes {
+			allBlockSlice = append(allBlockSlice, h.resourceTypesHCLBlocks[resType]...)
 		}
 		allBlockSlice = append(allBlockSlice, variablesBlock)
+		if len(h.importBlocks) > 0 {
+			allBlockSlice = append(allBlockSlice, createHCLImportBlocks(h.importBlocks))
+		}
+		if len(h.movedBlocks) > 0 {
+			allBlockSlice = append(allBlockSlice, createHCLMovedBlocks(h.movedBlocks))
+		}
 
 		hclFilePath := filepath.Join(h.dirPath, defaultTfHCLFile)
 		if hclFilePath == "" {
 			return diag.Errorf("Failed to create file path %s", hclFilePath)
 		}
-		if diagErr := writeHCLToFile(allBlockSlice, hclFilePath); diagErr != nil {
+		if diagErr := h.writeHCLFile(allBlockSlice, hclFilePath); diagErr != nil {
 			return diagErr
 		}
 	}
@@ -111,7 +158,7 @@ func (h *HCLExporter) exportHCLConfig() diag.Diagnostics {
 		if tfVarsFilePath == "" {
 			return diag.Errorf("Failed to create tfvars file path %s", tfVarsFilePath)
 		}
-		if diagErr := writeTfVars(tfVars, tfVarsFilePath); diagErr != nil {
+		if diagErr := writeTfVars(tfVars, tfVarsFilePath, h.incremental); diagErr != nil {
 			return diagErr
 		}
 	}
@@ -164,6 +211,33 @@ func createHCLVariablesBlock(unresolvedAttrs []unresolvableAttributeInfo) []byte
 	return mFile.Bytes()
 }
 
+// Create HCL import blocks in the format import { to = type.name, id = "..." }
+func createHCLImportBlocks(importBlocks []importBlock) []byte {
+	mFile := hclwrite.NewEmptyFile()
+	for _, i := range importBlocks {
+		importBody := mFile.Body().AppendNewBlock("import", nil).Body()
+		address := strings.Split(i.address(), ".")
+		to := hcl.Traversal{hcl.TraverseRoot{Name: address[0]}}
+		for _, name := range address[1:] {
+			to = append(to, hcl.TraverseAttr{Name: name})
+		}
+		importBody.SetAttributeTraversal("to", to)
+		importBody.SetAttributeValue("id", zclconfCty.StringVal(i.Id))
+	}
+	return mFile.Bytes()
+}
+
+// Create HCL moved blocks in the format moved { from = type.old_name, to = type.new_name }
+func createHCLMovedBlocks(movedBlocks []movedBlock) []byte {
+	mFile := hclwrite.NewEmptyFile()
+	for _, m := range movedBlocks {
+		movedBody := mFile.Body().AppendNewBlock("moved", nil).Body()
+		movedBody.SetAttributeTraversal("from", hcl.Traversal{hcl.TraverseRoot{Name: m.ResourceType}, hcl.TraverseAttr{Name: m.From}})
+		movedBody.SetAttributeTraversal("to", hcl.Traversal{hcl.TraverseRoot{Name: m.ResourceType}, hcl.TraverseAttr{Name: m.To}})
+	}
+	return mFile.Bytes()
+}
+
 func postProcessHclBytes(resource []byte) []byte {
 	resourceStr := string(resource)
 	for placeholderId, val := range attributesDecoded {
@@ -174,6 +248,14 @@ func postProcessHclBytes(resource []byte) []byte {
 	return []byte(resourceStr)
 }
 
+// writeHCLFile writes the blocks of an HCL file. Incremental exports leave files whose content is unchanged untouched.
+func (h *HCLExporter) writeHCLFile(blocks [][]byte, path string) diag.Diagnostics {
+	if h.incremental {
+		return writeHCLToFileIfChanged(blocks, path)
+	}
+	return writeHCLToFile(blocks, path)
+}
+
 func writeHCLToFile(bytes [][]byte, path string) diag.Diagnostics {
 	// clear contents
 	_ = os.WriteFile(path, nil, os.ModePerm)
@@ -198,7 +280,18 @@ func writeHCLToFile(bytes [][]byte, path string) diag.Diagnostics {
 	return nil
 }
 
-func instanceStateToHCLBlock(resType, resName string, json util.JsonMap, isDataSource bool) []byte {
+func writeHCLToFileIfChanged(blocks [][]byte, path string) diag.Diagnostics {
+	var content bytes.Buffer
+	for _, v := range blocks {
+		content.Write(postProcessHclBytes(v))
+		content.WriteString("\n")
+	}
+	return files.WriteToFileIfChanged(content.Bytes(), path)
+}
+
+// instanceStateToHCLBlock converts the config of a resource into an HCL block. The attributes are written in order when
+// sortAttributes is set, so the blocks of incremental exports are the same every time.
+func instanceStateToHCLBlock(resType, resName string, json util.JsonMap, isDataSource bool, sortAttributes bool) []byte {
 	f := hclwrite.NewEmptyFile()
 	rootBody := f.Body()
 
@@ -211,21 +304,38 @@ func instanceStateToHCLBlock(resType, resName string, json util.JsonMap, isDataS
 
 	body := block.Body()
 
-	addBody(body, json)
+	addBody(body, json, sortAttributes)
 
 	newCopy := strings.Replace(string(f.Bytes()), "$${", "${", -1)
 	return []byte(newCopy)
 }
 
-func addBody(body *hclwrite.Body, json util.JsonMap) {
-	for k, v := range json {
-		addValue(body, k, v)
+func addBody(body *hclwrite.Body, json util.JsonMap, sortAttributes bool) {
+	for _, k := range mapKeys(json, sortAttributes) {
+		addValue(body, k, json[k], sortAttributes)
 	}
 }
 
-func addValue(body *hclwrite.Body, k string, v interface{}) {
+// mapKeys returns the keys of the map, in order when sorted is set
+func mapKeys(m map[string]interface{}, sorted bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if sorted {
+		sort.Strings(keys)
+	}
+	return keys
+}
+
+// sortedKeys returns the keys of the map in order
+func sortedKeys(m map[string]interface{}) []string {
+	return mapKeys(m, true)
+}
+
+func addValue(body *hclwrite.Body, k string, v interface{}, sortAttributes bool) {
 	if vInter, ok := v.([]interface{}); ok {
-		handleInterfaceArray(body, k, vInter)
+		handleInterfaceArray(body, k, vInter, sortAttributes)
 	} else {
 		ctyVal := getCtyValue(v)
 		if ctyVal != zclconfCty.NilVal {
@@ -279,7 +389,7 @@ func createHCLObject(v map[string]interface{}) zclconfCty.Value {
 	return zclconfCty.ObjectVal(obj)
 }
 
-func handleInterfaceArray(body *hclwrite.Body, k string, v []interface{}) {
+func handleInterfaceArray(body *hclwrite.Body, k string, v []interface{}, sortAttributes bool) {
 	var listItems []zclconfCty.Value
 
 	nestedBlock := false
@@ -287,8 +397,8 @@ func handleInterfaceArray(body *hclwrite.Body, k string, v []interface{}) {
 		// k { ... }
 		if valMap, ok := val.(map[string]interface{}); ok {
 			block := body.AppendNewBlock(k, nil)
-			for key, value := range valMap {
-				addValue(block.Body(), key, value)
+			for _, key := range mapKeys(valMap, sortAttributes) {
+				addValue(block.Body(), key, valMap[key], sortAttributes)
 			}
 			nestedBlock = true
 			// k = [ ... ]
@@ -0,0 +1,142 @@
+package tfexporter
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+/*
+This file contains the logic for incremental exports. An incremental export writes a manifest to the export directory recording
+the version (or modified date) of every object along with the state that was read for it. The next incremental export to the same
+directory reuses the recorded state of any object whose version reported by its exporter's GetResourcesFunc has not changed, so only
+new or modified objects are read from Genesys Cloud again. Objects whose exporter does not report a version are always read.
+*/
+
+type exportManifest struct {
+	ProviderVersion string                          `json:"provider_version"`
+	Resources       map[string]*exportManifestEntry `json:"resources"`
+
+	reused int
+	mutex  sync.Mutex
+}
+
+type exportManifestEntry struct {
+	Version string                   `json:"version"`
+	State   *terraform.InstanceState `json:"state"`
+}
+
+func newExportManifest(providerVersion string) *exportManifest {
+	return &exportManifest{
+		ProviderVersion: providerVersion,
+		Resources:       make(map[string]*exportManifestEntry),
+	}
+}
+
+// readExportManifest reads the manifest written by a previous incremental export. A missing or unreadable manifest, or one
+// written by a different provider version, returns nil so every object is read from Genesys Cloud.
+func readExportManifest(dirPath string, providerVersion string) *exportManifest {
+	manifestPath := filepath.Join(dirPath, defaultExportManifestFile)
+	data, err := os.ReadFile(manifestPath)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Printf("Failed to read export manifest %s: %v", manifestPath, err)
+		}
+		return nil
+	}
+
+	manifest := newExportManifest("")
+	if err := json.Unmarshal(data, manifest); err != nil {
+		log.Printf("Failed to parse export manifest %s: %v", manifestPath, err)
+		return nil
+	}
+	if manifest.ProviderVersion != providerVersion {
+		log.Printf("Export manifest %s was written by provider version %s. All objects will be read again.", manifestPath, manifest.ProviderVersion)
+		return nil
+	}
+	return manifest
+}
+
+func manifestKey(resType string, id string) string {
+	return resType + "::" + id
+}
+
+// reusableState returns the recorded state of an object if its version is unchanged since the manifest was written
+func (m *exportManifest) reusableState(resType string, id string, resMeta *resourceExporter.ResourceMeta) *terraform.InstanceState {
+	if m == nil || resMeta.Version == "" {
+		return nil
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	entry, ok := m.Resources[manifestKey(resType, id)]
+	if !ok || entry.State == nil || entry.Version != resMeta.Version {
+		return nil
+	}
+	return entry.State.DeepCopy()
+}
+
+// record adds the state of an object to the manifest. Objects without a version are not recorded as they can never be reused.
+func (m *exportManifest) record(resType string, id string, resMeta *resourceExporter.ResourceMeta, state *terraform.InstanceState, reused bool) {
+	if resMeta.Version == "" {
+		return
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.Resources[manifestKey(resType, id)] = &exportManifestEntry{
+		Version: resMeta.Version,
+		State:   state.DeepCopy(),
+	}
+	if reused {
+		m.reused++
+	}
+}
+
+func (m *exportManifest) write(dirPath string) diag.Diagnostics {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return diag.Errorf("Failed to encode export manifest as JSON: %v", err)
+	}
+
+	manifestPath := filepath.Join(dirPath, defaultExportManifestFile)
+	log.Printf("Writing export manifest to %s. Reused the state of %d unchanged objects.", manifestPath, m.reused)
+	return files.WriteToFileIfChanged(data, manifestPath)
+}
+
+// resourceState returns the state of an object. Incremental exports reuse the state recorded by the previous export when the
+// object is unchanged and record the state of every object for the next export.
+func (g *GenesysCloudResourceExporter) resourceState(ctx context.Context, resource *schema.Resource, resType string, resID string, resMeta *resourceExporter.ResourceMeta, meta interface{}) (*terraform.InstanceState, diag.Diagnostics) {
+	if !g.incremental {
+		return getResourceState(ctx, resource, resID, resMeta, meta)
+	}
+
+	if state := g.previousManifest.reusableState(resType, resID, resMeta); state != nil {
+		g.manifest.record(resType, resID, resMeta, state, true)
+		return state, nil
+	}
+
+	state, err := getResourceState(ctx, resource, resID, resMeta, meta)
+	if err == nil && state != nil {
+		g.manifest.record(resType, resID, resMeta, state, false)
+	}
+	return state, err
+}
+
+// writeExportFile writes a file of the export. Incremental exports leave files whose content is unchanged untouched, so their
+// modification times show what changed between exports.
+func writeExportFile(data []byte, path string, incremental bool) diag.Diagnostics {
+	if incremental {
+		return files.WriteToFileIfChanged(data, path)
+	}
+	return files.WriteToFile(data, path)
+}
@@ -0,0 +1,123 @@
+package tfexporter
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// TestUnitExportManifestRoundTrip verifies the state recorded by an incremental export is only reused for unchanged objects
+func TestUnitExportManifestRoundTrip(t *testing.T) {
+	const (
+		resType         = "genesyscloud_routing_skill"
+		providerVersion = "0.1.0"
+	)
+	dir := t.TempDir()
+
+	if readExportManifest(dir, providerVersion) != nil {
+		t.Fatalf("expected no manifest in an empty export directory")
+	}
+
+	state := &terraform.InstanceState{ID: "skill-1", Attributes: map[string]string{"id": "skill-1", "name": "Skill 1"}}
+	manifest := newExportManifest(providerVersion)
+	manifest.record(resType, "skill-1", &resourceExporter.ResourceMeta{Name: "Skill_1", Version: "v1"}, state, false)
+	manifest.record(resType, "skill-2", &resourceExporter.ResourceMeta{Name: "Skill_2"}, state, false)
+	if diagErr := manifest.write(dir); diagErr != nil {
+		t.Fatalf("failed to write manifest: %v", diagErr)
+	}
+
+	if readExportManifest(dir, "0.2.0") != nil {
+		t.Errorf("expected a manifest written by another provider version to be ignored")
+	}
+
+	previous := readExportManifest(dir, providerVersion)
+	if previous == nil {
+		t.Fatalf("expected to read the manifest from %s", dir)
+	}
+	if len(previous.Resources) != 1 {
+		t.Errorf("expected only objects with a version to be recorded, got %d entries", len(previous.Resources))
+	}
+
+	reused := previous.reusableState(resType, "skill-1", &resourceExporter.ResourceMeta{Version: "v1"})
+	if reused == nil || reused.ID != "skill-1" || reused.Attributes["name"] != "Skill 1" {
+		t.Errorf("expected the recorded state to be reused for an unchanged object, got %v", reused)
+	}
+	if previous.reusableState(resType, "skill-1", &resourceExporter.ResourceMeta{Version: "v2"}) != nil {
+		t.Errorf("expected a modified object to be read again")
+	}
+	if previous.reusableState(resType, "skill-1", &resourceExporter.ResourceMeta{}) != nil {
+		t.Errorf("expected an object without a version to be read again")
+	}
+	if previous.reusableState(resType, "skill-3", &resourceExporter.ResourceMeta{Version: "v1"}) != nil {
+		t.Errorf("expected a new object to be read")
+	}
+}
+
+// TestUnitIncrementalExportFiles verifies incremental exports write attributes in order and leave unchanged files untouched
+func TestUnitIncrementalExportFiles(t *testing.T) {
+	config := util.JsonMap{"name": "Queue 1", "description": "Sales", "acw_timeout_ms": 300000}
+	expected := "resource \"genesyscloud_routing_queue\" \"Queue_1\" {\n  acw_timeout_ms = 300000\n  description    = \"Sales\"\n  name           = \"Queue 1\"\n}\n"
+	for i := 0; i < 5; i++ {
+		if block := string(instanceStateToHCLBlock("genesyscloud_routing_queue", "Queue_1", config, false, true)); block != expected {
+			t.Fatalf("expected attributes in order, got %s", block)
+		}
+	}
+
+	path := filepath.Join(t.TempDir(), "genesyscloud.tf")
+	if diagErr := writeExportFile([]byte(expected), path, true); diagErr != nil {
+		t.Fatalf("failed to write %s: %v", path, diagErr)
+	}
+	modified := time.Now().Add(-time.Hour)
+	if err := os.Chtimes(path, modified, modified); err != nil {
+		t.Fatalf("failed to set the modification time of %s: %v", path, err)
+	}
+	if diagErr := writeExportFile([]byte(expected), path, true); diagErr != nil {
+		t.Fatalf("failed to write %s: %v", path, diagErr)
+	}
+	if info, err := os.Stat(path); err != nil || !info.ModTime().Equal(modified) {
+		t.Errorf("expected an unchanged file to be left untouched")
+	}
+	if diagErr := writeExportFile([]byte(expected), path, false); diagErr != nil {
+		t.Fatalf("failed to write %s: %v", path, diagErr)
+	}
+	if info, err := os.Stat(path); err != nil || info.ModTime().Equal(modified) {
+		t.Errorf("expected files of full exports to be rewritten")
+	}
+}
+
+// TestUnitIncrementalExportUnversionedObjects verifies objects whose exporter reports no version, e.g. users whose skills changed,
+// are read again even when a previous manifest recorded their state
+func TestUnitIncrementalExportUnversionedObjects(t *testing.T) {
+	const resType = "genesyscloud_user"
+	previous := newExportManifest("0.1.0")
+	previous.record(resType, "user-1", &resourceExporter.ResourceMeta{Version: "3"}, &terraform.InstanceState{ID: "user-1", Attributes: map[string]string{"id": "user-1", "skill": "Old"}}, false)
+
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{"skill": {Type: schema.TypeString, Optional: true}},
+		ReadContext: func(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+			_ = d.Set("skill", "New")
+			return nil
+		},
+	}
+	g := &GenesysCloudResourceExporter{incremental: true, previousManifest: previous, manifest: newExportManifest("0.1.0")}
+
+	state, diagErr := g.resourceState(context.Background(), resource, resType, "user-1", &resourceExporter.ResourceMeta{Name: "user@example.com"}, nil)
+	if diagErr != nil {
+		t.Fatalf("failed to read the state: %v", diagErr)
+	}
+	if state == nil || state.Attributes["skill"] != "New" {
+		t.Errorf("expected the changed skill to be read, got %v", state)
+	}
+	if len(g.manifest.Resources) != 0 {
+		t.Errorf("expected objects without a version not to be recorded")
+	}
+}
@@ -7,7 +7,6 @@ import (
 	"path/filepath"
 	"strings"
 	"terraform-provider-genesyscloud/genesyscloud/util"
-	"terraform-provider-genesyscloud/genesyscloud/util/files"
 
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 
@@ -23,21 +22,27 @@ type JsonExporter struct {
 	resourceTypesJSONMaps map[string]resourceJSONMaps
 	dataSourceTypesMaps   map[string]resourceJSONMaps
 	unresolvedAttrs       []unresolvableAttributeInfo
+	importBlocks          []importBlock
+	movedBlocks           []movedBlock
 	providerSource        string
 	version               string
 	dirPath               string
 	splitFilesByResource  bool
+	incremental           bool
 }
 
-func NewJsonExporter(resourceTypesJSONMaps map[string]resourceJSONMaps, dataSourceTypesMaps map[string]resourceJSONMaps, unresolvedAttrs []unresolvableAttributeInfo, providerSource string, version string, dirPath string, splitFilesByResource bool) *JsonExporter {
+func NewJsonExporter(resourceTypesJSONMaps map[string]resourceJSONMaps, dataSourceTypesMaps map[string]resourceJSONMaps, unresolvedAttrs []unresolvableAttributeInfo, importBlocks []importBlock, movedBlocks []movedBlock, providerSource string, version string, dirPath string, splitFilesByResource bool, incremental bool) *JsonExporter {
 	jsonExporter := &JsonExporter{
 		resourceTypesJSONMaps: resourceTypesJSONMaps,
 		dataSourceTypesMaps:   dataSourceTypesMaps,
 		unresolvedAttrs:       unresolvedAttrs,
+		importBlocks:          importBlocks,
+		movedBlocks:           movedBlocks,
 		providerSource:        providerSource,
 		version:               version,
 		dirPath:               dirPath,
 		splitFilesByResource:  splitFilesByResource,
+		incremental:           incremental,
 	}
 	return jsonExporter
 }
@@ -58,7 +63,7 @@ func (j *JsonExporter) exportJSONConfig() diag.Diagnostics {
 		if providerJSONFilePath == "" {
 			return diag.Errorf("Failed to create file path %s", providerJSONFilePath)
 		}
-		if diagErr := writeConfig(terraformRoot, providerJSONFilePath); diagErr != nil {
+		if diagErr := writeConfig(terraformRoot, providerJSONFilePath, j.incremental); diagErr != nil {
 			return diagErr
 		}
 
@@ -70,10 +75,32 @@ func (j *JsonExporter) exportJSONConfig() diag.Diagnostics {
 		if variablesJSONFilePath == "" {
 			return diag.Errorf("Failed to create file path %s", variablesJSONFilePath)
 		}
-		if diagErr := writeConfig(variablesRoot, variablesJSONFilePath); diagErr != nil {
+		if diagErr := writeConfig(variablesRoot, variablesJSONFilePath, j.incremental); diagErr != nil {
 			return diagErr
 		}
 
+		// Import file
+		if len(j.importBlocks) > 0 {
+			importsRoot := map[string]interface{}{
+				"import": createImportsJsonList(j.importBlocks),
+			}
+			importsJSONFilePath := filepath.Join(j.dirPath, defaultTfJSONImportsFile)
+			if diagErr := writeConfig(importsRoot, importsJSONFilePath, j.incremental); diagErr != nil {
+				return diagErr
+			}
+		}
+
+		// Moved file
+		if len(j.movedBlocks) > 0 {
+			movedRoot := map[string]interface{}{
+				"moved": createMovedJsonList(j.movedBlocks),
+			}
+			movedJSONFilePath := filepath.Join(j.dirPath, defaultTfJSONMovedFile)
+			if diagErr := writeConfig(movedRoot, movedJSONFilePath, j.incremental); diagErr != nil {
+				return diagErr
+			}
+		}
+
 		// Resource files
 		for resType, resJsonMap := range j.resourceTypesJSONMaps {
 			resourceRoot := map[string]interface{}{
@@ -86,7 +113,7 @@ func (j *JsonExporter) exportJSONConfig() diag.Diagnostics {
 			if resourceJSONFilePath == "" {
 				return diag.Errorf("Failed to create file path %s", resourceJSONFilePath)
 			}
-			if diagErr := writeConfig(resourceRoot, resourceJSONFilePath); diagErr != nil {
+			if diagErr := writeConfig(resourceRoot, resourceJSONFilePath, j.incremental); diagErr != nil {
 				return diagErr
 			}
 		}
@@ -103,7 +130,7 @@ func (j *JsonExporter) exportJSONConfig() diag.Diagnostics {
 			if resourceJSONFilePath == "" {
 				return diag.Errorf("Failed to create file path %s", resourceJSONFilePath)
 			}
-			if diagErr := writeConfig(resourceRoot, resourceJSONFilePath); diagErr != nil {
+			if diagErr := writeConfig(resourceRoot, resourceJSONFilePath, j.incremental); diagErr != nil {
 				return diagErr
 			}
 		}
@@ -120,12 +147,20 @@ func (j *JsonExporter) exportJSONConfig() diag.Diagnostics {
 			rootJSONObject["variable"] = variablesJsonMap
 		}
 
+		if len(j.importBlocks) > 0 {
+			rootJSONObject["import"] = createImportsJsonList(j.importBlocks)
+		}
+
+		if len(j.movedBlocks) > 0 {
+			rootJSONObject["moved"] = createMovedJsonList(j.movedBlocks)
+		}
+
 		jsonFilePath := filepath.Join(j.dirPath, defaultTfJSONFile)
 		if jsonFilePath == "" {
 			return diag.Errorf("Failed to create file path %s", jsonFilePath)
 		}
 
-		writeConfig(rootJSONObject, jsonFilePath)
+		writeConfig(rootJSONObject, jsonFilePath, j.incremental)
 	}
 
 	// Optional tfvars file creation for unresolved attributes
@@ -141,7 +176,7 @@ func (j *JsonExporter) exportJSONConfig() diag.Diagnostics {
 		if tfVarsFilePath == "" {
 			return diag.Errorf("Failed to create tfvars file path %s", tfVarsFilePath)
 		}
-		if err := writeTfVars(tfVars, tfVarsFilePath); err != nil {
+		if err := writeTfVars(tfVars, tfVarsFilePath, j.incremental); err != nil {
 			return err
 		}
 	}
@@ -160,6 +195,17 @@ func createProviderJsonMap(providerSource string, version string) util.JsonMap {
 	}
 }
 
+func createImportsJsonList(importBlocks []importBlock) []util.JsonMap {
+	imports := make([]util.JsonMap, 0, len(importBlocks))
+	for _, i := range importBlocks {
+		imports = append(imports, util.JsonMap{
+			"to": i.address(),
+			"id": i.Id,
+		})
+	}
+	return imports
+}
+
 func createVariablesJsonMap(unresolvedAttrs []unresolvableAttributeInfo) map[string]util.JsonMap {
 	variable := make(map[string]util.JsonMap)
 	for _, attr := range unresolvedAttrs {
@@ -277,14 +323,14 @@ func determineVarType(s *schema.Schema) string {
 	return varType
 }
 
-func writeConfig(jsonMap map[string]interface{}, path string) diag.Diagnostics {
+func writeConfig(jsonMap map[string]interface{}, path string, incremental bool) diag.Diagnostics {
 	dataJSONBytes, err := json.MarshalIndent(jsonMap, "", "  ")
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	log.Printf("Writing export config file to %s", path)
-	if err := files.WriteToFile(postProcessJsonBytes(dataJSONBytes), path); err != nil {
+	if err := writeExportFile(postProcessJsonBytes(dataJSONBytes), path, incremental); err != nil {
 		return err
 	}
 	return nil
@@ -295,3 +341,14 @@ func postProcessJsonBytes(resource []byte) []byte {
 	resourceStr = correctDependsOn(resourceStr, false)
 	return []byte(resourceStr)
 }
+
+func createMovedJsonList(movedBlocks []movedBlock) []util.JsonMap {
+	moved := make([]util.JsonMap, 0, len(movedBlocks))
+	for _, m := range movedBlocks {
+		moved = append(moved, util.JsonMap{
+			"from": m.fromAddress(),
+			"to":   m.toAddress(),
+		})
+	}
+	return moved
+}
@@ -0,0 +1,525 @@
+package tfexporter
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	zclconfCty "github.com/zclconf/go-cty/cty"
+)
+
+/*
+This file contains the logic to export the config as a root module calling one child module per group of resources. Resources are
+grouped either by their division or by the connected components of the references between them. References between resources in
+different modules are replaced with module variables, and the referenced attributes are exposed as outputs of the module that owns
+the resource. Data sources are declared in every module that references them so they never cross module boundaries.
+*/
+
+const (
+	moduleGroupingDivision   = "division"
+	moduleGroupingDependency = "dependency"
+
+	// Resources that do not belong to a division or a dependency cluster are exported to this module
+	commonModuleName = "common"
+	modulesDirName   = "modules"
+
+	defaultTfHCLModuleMainFile       = "main.tf"
+	defaultTfJSONModuleMainFile      = "main.tf.json"
+	defaultTfHCLModuleOutputsFile    = "outputs.tf"
+	defaultTfJSONModuleOutputsFile   = "outputs.tf.json"
+	defaultTfHCLModuleVariablesFile  = "variables.tf"
+	defaultTfJSONModuleVariablesFile = "variables.tf.json"
+)
+
+// Matches reference expressions such as ${genesyscloud_routing_queue.support.id} or ${data.genesyscloud_auth_division.home.id}
+var referenceExpressionRegex = regexp.MustCompile(`\$\{(data\.)?([a-z0-9_]+)\.([A-Za-z0-9_-]+)\.([A-Za-z0-9_]+)\}`)
+
+// Matches the depends_on placeholders added by addDependsOnValues
+var dependsOnPlaceholderRegex = regexp.MustCompile(`^\$dep\$([^$]+)\$dep\$$`)
+
+type exportModule struct {
+	name            string
+	resources       map[string]resourceJSONMaps
+	dataSources     map[string]resourceJSONMaps
+	unresolvedAttrs []unresolvableAttributeInfo
+
+	// Module variables set by the root module, keyed by variable name with the expression passed in by the root module
+	inputs map[string]string
+	// Module variables that reference a resource in another module, keyed by variable name with the referenced address
+	references map[string]string
+	// Module outputs read by other modules, keyed by output name with the value expression
+	outputs map[string]string
+}
+
+func newExportModule(name string) *exportModule {
+	return &exportModule{
+		name:        name,
+		resources:   make(map[string]resourceJSONMaps),
+		dataSources: make(map[string]resourceJSONMaps),
+		inputs:      make(map[string]string),
+		references:  make(map[string]string),
+		outputs:     make(map[string]string),
+	}
+}
+
+type ModuleExporter struct {
+	modules         map[string]*exportModule
+	unresolvedAttrs []unresolvableAttributeInfo
+	importBlocks    []importBlock
+	providerSource  string
+	version         string
+	dirPath         string
+	exportAsHCL     bool
+}
+
+func NewModuleExporter(modules map[string]*exportModule, unresolvedAttrs []unresolvableAttributeInfo, importBlocks []importBlock, providerSource string, version string, dirPath string, exportAsHCL bool) *ModuleExporter {
+	moduleExporter := &ModuleExporter{
+		modules:         modules,
+		unresolvedAttrs: unresolvedAttrs,
+		importBlocks:    importBlocks,
+		providerSource:  providerSource,
+		version:         version,
+		dirPath:         dirPath,
+		exportAsHCL:     exportAsHCL,
+	}
+	return moduleExporter
+}
+
+// buildExportModules groups the exported resources into modules and rewrites references that cross module boundaries
+func (g *GenesysCloudResourceExporter) buildExportModules() map[string]*exportModule {
+	var groups map[string]string
+	if g.moduleGrouping == moduleGroupingDivision {
+		groups = g.groupResourcesByDivision()
+	} else {
+		groups = g.groupResourcesByDependency()
+	}
+
+	// Sanitize the group names so they can be used as module names and directories
+	sanitizer := resourceExporter.NewSanitizerProvider()
+	for resType, resources := range g.resourceTypesMaps {
+		for resName := range resources {
+			address := resType + "." + resName
+			if group, ok := groups[address]; ok {
+				groups[address] = sanitizer.S.SanitizeResourceName(group)
+			} else {
+				groups[address] = commonModuleName
+			}
+		}
+	}
+
+	modules := make(map[string]*exportModule)
+	getModule := func(name string) *exportModule {
+		if modules[name] == nil {
+			modules[name] = newExportModule(name)
+		}
+		return modules[name]
+	}
+
+	for resType, resources := range g.resourceTypesMaps {
+		for resName, config := range resources {
+			module := getModule(groups[resType+"."+resName])
+			if module.resources[resType] == nil {
+				module.resources[resType] = make(resourceJSONMaps)
+			}
+			module.resources[resType][resName] = config
+		}
+	}
+
+	for _, attr := range g.unresolvedAttrs {
+		if moduleName, ok := groups[attr.ResourceType+"."+attr.ResourceName]; ok {
+			module := getModule(moduleName)
+			key := createUnresolvedAttrKey(attr)
+			if _, ok := module.inputs[key]; !ok {
+				module.unresolvedAttrs = append(module.unresolvedAttrs, attr)
+				module.inputs[key] = fmt.Sprintf("${var.%s}", key)
+			}
+		}
+	}
+
+	referencedDataSources := make(map[string]bool)
+	for _, module := range modules {
+		for _, resources := range module.resources {
+			for _, config := range resources {
+				removeCrossModuleDependsOn(config, groups, module.name)
+				walkConfigStrings(config, func(s string) string {
+					return referenceExpressionRegex.ReplaceAllStringFunc(s, func(match string) string {
+						parts := referenceExpressionRegex.FindStringSubmatch(match)
+						if parts[1] != "" {
+							// Declare the data source in this module rather than referencing it across modules
+							if dataSourceConfig, ok := g.dataSourceTypesMaps[parts[2]][parts[3]]; ok {
+								if module.dataSources[parts[2]] == nil {
+									module.dataSources[parts[2]] = make(resourceJSONMaps)
+								}
+								module.dataSources[parts[2]][parts[3]] = dataSourceConfig
+								referencedDataSources[parts[2]+"."+parts[3]] = true
+							}
+							return match
+						}
+
+						target, ok := groups[parts[2]+"."+parts[3]]
+						if !ok || target == module.name {
+							return match
+						}
+						varName := strings.Join(parts[2:], "_")
+						module.inputs[varName] = fmt.Sprintf("${module.%s.%s}", target, varName)
+						module.references[varName] = strings.Join(parts[2:], ".")
+						modules[target].outputs[varName] = match
+						return fmt.Sprintf("${var.%s}", varName)
+					})
+				})
+			}
+		}
+	}
+
+	// Data sources that are not referenced by any resource are kept in the common module
+	for dsType, dataSources := range g.dataSourceTypesMaps {
+		for dsName, config := range dataSources {
+			if referencedDataSources[dsType+"."+dsName] {
+				continue
+			}
+			module := getModule(commonModuleName)
+			if module.dataSources[dsType] == nil {
+				module.dataSources[dsType] = make(resourceJSONMaps)
+			}
+			module.dataSources[dsType][dsName] = config
+		}
+	}
+
+	for i, importBlock := range g.importBlocks {
+		g.importBlocks[i].Module = groups[importBlock.ResourceType+"."+importBlock.ResourceName]
+	}
+
+	return modules
+}
+
+// groupResourcesByDivision returns the division of every exported resource. Divisions are named after their exported
+// genesyscloud_auth_division resource when it is part of the export.
+func (g *GenesysCloudResourceExporter) groupResourcesByDivision() map[string]string {
+	divisionNames := make(map[string]string)
+	for _, resource := range g.resources {
+		if resource.Type == "genesyscloud_auth_division" {
+			divisionNames[resource.State.ID] = resource.Name
+		}
+	}
+
+	groups := make(map[string]string)
+	for _, resource := range g.resources {
+		if g.isDataSource(resource.Type, resource.Name) {
+			continue
+		}
+		divisionId := resource.State.Attributes["division_id"]
+		if resource.Type == "genesyscloud_auth_division" {
+			divisionId = resource.State.ID
+		}
+
+		group := commonModuleName
+		if name, ok := divisionNames[divisionId]; ok {
+			group = name
+		} else if divisionId != "" {
+			group = "division_" + divisionId
+		}
+		groups[resource.Type+"."+resource.Name] = group
+	}
+	return groups
+}
+
+// groupResourcesByDependency returns the dependency cluster of every exported resource. A cluster is a set of resources connected
+// by references and is named after its first resource. Resources that neither reference nor are referenced by another resource
+// are grouped together in the common module.
+func (g *GenesysCloudResourceExporter) groupResourcesByDependency() map[string]string {
+	parents := make(map[string]string)
+	var find func(address string) string
+	find = func(address string) string {
+		if parents[address] != address {
+			parents[address] = find(parents[address])
+		}
+		return parents[address]
+	}
+	union := func(a, b string) {
+		rootA, rootB := find(a), find(b)
+		if rootA == rootB {
+			return
+		}
+		// Keep the lowest address as the root so cluster names are stable between exports
+		if rootA < rootB {
+			parents[rootB] = rootA
+		} else {
+			parents[rootA] = rootB
+		}
+	}
+
+	for resType, resources := range g.resourceTypesMaps {
+		for resName := range resources {
+			parents[resType+"."+resName] = resType + "." + resName
+		}
+	}
+
+	for resType, resources := range g.resourceTypesMaps {
+		for resName, config := range resources {
+			address := resType + "." + resName
+			walkConfigStrings(config, func(s string) string {
+				for _, parts := range referenceExpressionRegex.FindAllStringSubmatch(s, -1) {
+					if target := parts[2] + "." + parts[3]; parts[1] == "" && parents[target] != "" {
+						union(address, target)
+					}
+				}
+				if parts := dependsOnPlaceholderRegex.FindStringSubmatch(s); parts != nil && parents[parts[1]] != "" {
+					union(address, parts[1])
+				}
+				return s
+			})
+		}
+	}
+
+	clusterSizes := make(map[string]int)
+	for address := range parents {
+		clusterSizes[find(address)]++
+	}
+
+	groups := make(map[string]string)
+	for address := range parents {
+		root := find(address)
+		if clusterSizes[root] == 1 {
+			groups[address] = commonModuleName
+		} else {
+			groups[address] = strings.Replace(root, ".", "_", 1)
+		}
+	}
+	return groups
+}
+
+// removeCrossModuleDependsOn drops depends_on entries for resources in other modules as they cannot be referenced directly
+func removeCrossModuleDependsOn(config util.JsonMap, groups map[string]string, moduleName string) {
+	dependsOn, ok := config["depends_on"].([]string)
+	if !ok {
+		return
+	}
+	filtered := make([]string, 0, len(dependsOn))
+	for _, dep := range dependsOn {
+		if parts := dependsOnPlaceholderRegex.FindStringSubmatch(dep); parts != nil {
+			if target, ok := groups[parts[1]]; ok && target != moduleName {
+				continue
+			}
+		}
+		filtered = append(filtered, dep)
+	}
+	if len(filtered) == 0 {
+		delete(config, "depends_on")
+	} else {
+		config["depends_on"] = filtered
+	}
+}
+
+// walkConfigStrings replaces every string in the config with the value returned by fn. Strings that were moved out of
+// the config into jsonencode placeholders are updated in place.
+func walkConfigStrings(value interface{}, fn func(string) string) interface{} {
+	switch v := value.(type) {
+	case string:
+		if decoded, ok := attributesDecoded[v]; ok {
+			attributesDecoded[v] = fn(decoded)
+			return v
+		}
+		return fn(v)
+	case util.JsonMap:
+		for k, val := range v {
+			v[k] = walkConfigStrings(val, fn)
+		}
+	case map[string]interface{}:
+		for k, val := range v {
+			v[k] = walkConfigStrings(val, fn)
+		}
+	case []interface{}:
+		for i, val := range v {
+			v[i] = walkConfigStrings(val, fn)
+		}
+	case []string:
+		for i, val := range v {
+			v[i] = fn(val)
+		}
+	}
+	return value
+}
+
+// sortedMapKeys returns the keys of the map in order so modules are always written in the same order
+func sortedMapKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (m *ModuleExporter) exportModules() diag.Diagnostics {
+	for _, name := range sortedMapKeys(m.modules) {
+		moduleDirPath := filepath.Join(m.dirPath, modulesDirName, name)
+		if err := os.MkdirAll(moduleDirPath, os.ModePerm); err != nil {
+			return diag.Errorf("Failed to create module directory %s: %v", moduleDirPath, err)
+		}
+
+		var diagErr diag.Diagnostics
+		if m.exportAsHCL {
+			diagErr = m.writeHCLModule(m.modules[name], moduleDirPath)
+		} else {
+			diagErr = m.writeJSONModule(m.modules[name], moduleDirPath)
+		}
+		if diagErr != nil {
+			return diagErr
+		}
+	}
+
+	var diagErr diag.Diagnostics
+	if m.exportAsHCL {
+		diagErr = m.writeHCLRootModule()
+	} else {
+		diagErr = m.writeJSONRootModule()
+	}
+	if diagErr != nil {
+		return diagErr
+	}
+
+	// Optional tfvars file creation for unresolved attributes
+	if len(m.unresolvedAttrs) > 0 {
+		tfVars := make(map[string]interface{})
+		for _, attr := range m.unresolvedAttrs {
+			tfVars[createUnresolvedAttrKey(attr)] = determineVarValue(attr.Schema)
+		}
+		if diagErr := writeTfVars(tfVars, filepath.Join(m.dirPath, defaultTfVarsFile), false); diagErr != nil {
+			return diagErr
+		}
+	}
+	return nil
+}
+
+func (m *ModuleExporter) writeHCLModule(module *exportModule, moduleDirPath string) diag.Diagnostics {
+	blocks := [][]byte{createHCLProviderBlock(m.providerSource, m.version)}
+	for _, resType := range sortedMapKeys(module.resources) {
+		for _, resName := range sortedMapKeys(module.resources[resType]) {
+			blocks = append(blocks, instanceStateToHCLBlock(resType, resName, module.resources[resType][resName], false, false))
+		}
+	}
+	for _, dsType := range sortedMapKeys(module.dataSources) {
+		for _, dsName := range sortedMapKeys(module.dataSources[dsType]) {
+			blocks = append(blocks, instanceStateToHCLBlock(dsType, dsName, module.dataSources[dsType][dsName], true, false))
+		}
+	}
+	if diagErr := writeHCLToFile(blocks, filepath.Join(moduleDirPath, defaultTfHCLModuleMainFile)); diagErr != nil {
+		return diagErr
+	}
+
+	if len(module.inputs) > 0 {
+		variables := [][]byte{createHCLVariablesBlock(module.unresolvedAttrs), createHCLReferenceVariablesBlock(module.references)}
+		if diagErr := writeHCLToFile(variables, filepath.Join(moduleDirPath, defaultTfHCLModuleVariablesFile)); diagErr != nil {
+			return diagErr
+		}
+	}
+
+	if len(module.outputs) > 0 {
+		outputsFile := hclwrite.NewEmptyFile()
+		for _, name := range sortedMapKeys(module.outputs) {
+			outputsFile.Body().AppendNewBlock("output", []string{name}).Body().SetAttributeValue("value", zclconfCty.StringVal(module.outputs[name]))
+		}
+		if diagErr := writeHCLToFile([][]byte{unescapeHCLInterpolation(outputsFile.Bytes())}, filepath.Join(moduleDirPath, defaultTfHCLModuleOutputsFile)); diagErr != nil {
+			return diagErr
+		}
+	}
+	return nil
+}
+
+func (m *ModuleExporter) writeHCLRootModule() diag.Diagnostics {
+	rootFile := hclwrite.NewEmptyFile()
+	for _, name := range sortedMapKeys(m.modules) {
+		moduleBody := rootFile.Body().AppendNewBlock("module", []string{name}).Body()
+		moduleBody.SetAttributeValue("source", zclconfCty.StringVal("./"+modulesDirName+"/"+name))
+		for _, input := range sortedMapKeys(m.modules[name].inputs) {
+			moduleBody.SetAttributeValue(input, zclconfCty.StringVal(m.modules[name].inputs[input]))
+		}
+	}
+
+	blocks := [][]byte{createHCLProviderBlock(m.providerSource, m.version), unescapeHCLInterpolation(rootFile.Bytes()), createHCLVariablesBlock(m.unresolvedAttrs)}
+	if len(m.importBlocks) > 0 {
+		blocks = append(blocks, createHCLImportBlocks(m.importBlocks))
+	}
+	return writeHCLToFile(blocks, filepath.Join(m.dirPath, defaultTfHCLFile))
+}
+
+func (m *ModuleExporter) writeJSONModule(module *exportModule, moduleDirPath string) diag.Diagnostics {
+	mainRoot := util.JsonMap{
+		"terraform": createProviderJsonMap(m.providerSource, m.version),
+	}
+	if len(module.resources) > 0 {
+		mainRoot["resource"] = module.resources
+	}
+	if len(module.dataSources) > 0 {
+		mainRoot["data"] = module.dataSources
+	}
+	if diagErr := writeConfig(mainRoot, filepath.Join(moduleDirPath, defaultTfJSONModuleMainFile), false); diagErr != nil {
+		return diagErr
+	}
+
+	if len(module.inputs) > 0 {
+		variables := createVariablesJsonMap(module.unresolvedAttrs)
+		for name, address := range module.references {
+			variables[name] = util.JsonMap{"description": fmt.Sprintf("Reference to %s", address)}
+		}
+		if diagErr := writeConfig(util.JsonMap{"variable": variables}, filepath.Join(moduleDirPath, defaultTfJSONModuleVariablesFile), false); diagErr != nil {
+			return diagErr
+		}
+	}
+
+	if len(module.outputs) > 0 {
+		outputs := make(util.JsonMap)
+		for name, value := range module.outputs {
+			outputs[name] = util.JsonMap{"value": value}
+		}
+		if diagErr := writeConfig(util.JsonMap{"output": outputs}, filepath.Join(moduleDirPath, defaultTfJSONModuleOutputsFile), false); diagErr != nil {
+			return diagErr
+		}
+	}
+	return nil
+}
+
+func (m *ModuleExporter) writeJSONRootModule() diag.Diagnostics {
+	modules := make(util.JsonMap)
+	for name, module := range m.modules {
+		moduleMap := util.JsonMap{"source": "./" + modulesDirName + "/" + name}
+		for input, value := range module.inputs {
+			moduleMap[input] = value
+		}
+		modules[name] = moduleMap
+	}
+
+	rootJSONObject := util.JsonMap{
+		"terraform": createProviderJsonMap(m.providerSource, m.version),
+		"module":    modules,
+	}
+	if variablesJsonMap := createVariablesJsonMap(m.unresolvedAttrs); len(variablesJsonMap) > 0 {
+		rootJSONObject["variable"] = variablesJsonMap
+	}
+	if len(m.importBlocks) > 0 {
+		rootJSONObject["import"] = createImportsJsonList(m.importBlocks)
+	}
+	return writeConfig(rootJSONObject, filepath.Join(m.dirPath, defaultTfJSONFile), false)
+}
+
+// Create HCL variable blocks for references to resources in other modules
+func createHCLReferenceVariablesBlock(references map[string]string) []byte {
+	mFile := hclwrite.NewEmptyFile()
+	for _, name := range sortedMapKeys(references) {
+		variableBlock := mFile.Body().AppendNewBlock("variable", []string{name})
+		variableBlock.Body().SetAttributeValue("description", zclconfCty.StringVal(fmt.Sprintf("Reference to %s", references[name])))
+	}
+	return mFile.Bytes()
+}
+
+// hclwrite escapes interpolation sequences in string values. Reference expressions need to stay interpolated.
+func unescapeHCLInterpolation(b []byte) []byte {
+	return []byte(strings.Replace(string(b), "$${", "${", -1))
+}
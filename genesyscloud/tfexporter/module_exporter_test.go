@@ -0,0 +1,133 @@
+package tfexporter
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func moduleTestExporter(moduleGrouping string) *GenesysCloudResourceExporter {
+	resourceInfo := func(resType string, name string, id string, divisionId string) resourceExporter.ResourceInfo {
+		return resourceExporter.ResourceInfo{
+			Type:  resType,
+			Name:  name,
+			State: &terraform.InstanceState{ID: id, Attributes: map[string]string{"division_id": divisionId}},
+		}
+	}
+
+	return &GenesysCloudResourceExporter{
+		moduleGrouping: moduleGrouping,
+		exportAsHCL:    true,
+		resources: []resourceExporter.ResourceInfo{
+			resourceInfo("genesyscloud_auth_division", "Sales", "div-sales", ""),
+			resourceInfo("genesyscloud_auth_division", "Support", "div-support", ""),
+			resourceInfo("genesyscloud_routing_skill", "english", "skill-1", ""),
+			resourceInfo("genesyscloud_routing_queue", "sales_queue", "queue-1", "div-sales"),
+			resourceInfo("genesyscloud_routing_queue", "support_queue", "queue-2", "div-support"),
+		},
+		resourceTypesMaps: map[string]resourceJSONMaps{
+			"genesyscloud_auth_division": {
+				"Sales":   util.JsonMap{"name": "Sales"},
+				"Support": util.JsonMap{"name": "Support"},
+			},
+			"genesyscloud_routing_skill": {
+				"english": util.JsonMap{"name": "English"},
+			},
+			"genesyscloud_routing_queue": {
+				"sales_queue": util.JsonMap{
+					"name":        "Sales Queue",
+					"division_id": "${genesyscloud_auth_division.Sales.id}",
+					"skill_ids":   []interface{}{"${genesyscloud_routing_skill.english.id}"},
+				},
+				"support_queue": util.JsonMap{
+					"name":        "Support Queue",
+					"division_id": "${genesyscloud_auth_division.Support.id}",
+					"depends_on":  []string{"$dep$genesyscloud_routing_queue.sales_queue$dep$"},
+				},
+			},
+		},
+		dataSourceTypesMaps: map[string]resourceJSONMaps{},
+		importBlocks: []importBlock{
+			{ResourceType: "genesyscloud_routing_queue", ResourceName: "sales_queue", Id: "queue-1"},
+		},
+	}
+}
+
+// TestUnitBuildExportModulesByDivision verifies resources are grouped by division and cross module references become variables
+func TestUnitBuildExportModulesByDivision(t *testing.T) {
+	g := moduleTestExporter(moduleGroupingDivision)
+	modules := g.buildExportModules()
+
+	assert.ElementsMatch(t, []string{"Sales", "Support", commonModuleName}, sortedMapKeys(modules))
+	assert.Contains(t, modules["Sales"].resources["genesyscloud_routing_queue"], "sales_queue")
+	assert.Contains(t, modules["Support"].resources["genesyscloud_routing_queue"], "support_queue")
+	assert.Contains(t, modules[commonModuleName].resources["genesyscloud_routing_skill"], "english")
+
+	salesQueue := modules["Sales"].resources["genesyscloud_routing_queue"]["sales_queue"]
+	assert.Equal(t, "${genesyscloud_auth_division.Sales.id}", salesQueue["division_id"])
+	assert.Equal(t, []interface{}{"${var.genesyscloud_routing_skill_english_id}"}, salesQueue["skill_ids"])
+	assert.Equal(t, "${module.common.genesyscloud_routing_skill_english_id}", modules["Sales"].inputs["genesyscloud_routing_skill_english_id"])
+	assert.Equal(t, "${genesyscloud_routing_skill.english.id}", modules[commonModuleName].outputs["genesyscloud_routing_skill_english_id"])
+
+	// depends_on cannot reference resources in other modules
+	assert.NotContains(t, modules["Support"].resources["genesyscloud_routing_queue"]["support_queue"], "depends_on")
+
+	assert.Equal(t, "module.Sales.genesyscloud_routing_queue.sales_queue", g.importBlocks[0].address())
+}
+
+// TestUnitBuildExportModulesByDependency verifies resources connected by references are exported to the same module
+func TestUnitBuildExportModulesByDependency(t *testing.T) {
+	g := moduleTestExporter(moduleGroupingDependency)
+	modules := g.buildExportModules()
+
+	// The sales queue references the Sales division and the skill, and the support queue depends on the sales queue
+	cluster := "genesyscloud_auth_division_Sales"
+	assert.Len(t, modules, 1)
+	assert.Len(t, modules[cluster].resources["genesyscloud_auth_division"], 2)
+	assert.Len(t, modules[cluster].resources["genesyscloud_routing_queue"], 2)
+	assert.Empty(t, modules[cluster].inputs)
+	assert.Contains(t, modules[cluster].resources["genesyscloud_routing_queue"]["support_queue"], "depends_on")
+}
+
+// TestUnitExportModulesHCL verifies the root module and child module files are written
+func TestUnitExportModulesHCL(t *testing.T) {
+	dir := t.TempDir()
+	g := moduleTestExporter(moduleGroupingDivision)
+	moduleExporter := NewModuleExporter(g.buildExportModules(), nil, g.importBlocks, "mypurecloud/genesyscloud", "0.1.0", dir, true)
+	if diagErr := moduleExporter.exportModules(); diagErr != nil {
+		t.Fatalf("failed to export modules: %v", diagErr)
+	}
+
+	root, err := os.ReadFile(filepath.Join(dir, defaultTfHCLFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{
+		`module "Sales" {`,
+		`"./modules/Sales"`,
+		`genesyscloud_routing_skill_english_id = "${module.common.genesyscloud_routing_skill_english_id}"`,
+		`to = module.Sales.genesyscloud_routing_queue.sales_queue`,
+	} {
+		assert.True(t, strings.Contains(string(root), expected), "expected root module to contain %q:\n%s", expected, root)
+	}
+
+	outputs, err := os.ReadFile(filepath.Join(dir, modulesDirName, commonModuleName, defaultTfHCLModuleOutputsFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Contains(t, string(outputs), `value = "${genesyscloud_routing_skill.english.id}"`)
+
+	variables, err := os.ReadFile(filepath.Join(dir, modulesDirName, "Sales", defaultTfHCLModuleVariablesFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Contains(t, string(variables), `variable "genesyscloud_routing_skill_english_id"`)
+	assert.FileExists(t, filepath.Join(dir, modulesDirName, "Support", defaultTfHCLModuleMainFile))
+}
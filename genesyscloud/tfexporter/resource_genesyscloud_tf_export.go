@@ -15,6 +15,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 type fileMeta struct {
@@ -71,6 +72,16 @@ func ResourceTfExport() *schema.Resource {
 				ForceNew:      true,
 				ConflictsWith: []string{"resource_types", "exclude_filter_resources"},
 			},
+			"state_filters": {
+				Description: "Only export objects whose state matches every filter that applies to them. A filter is either a list of predicates for a resource type, e.g. `genesyscloud_routing_queue[division=Sales]` or `genesyscloud_user[state=active]`, or a single predicate that applies to every resource type with the attribute, e.g. `modified_after=2026-01-01`. See export guide for additional information",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateStateFilter,
+				},
+				ForceNew: true,
+			},
 			"replace_with_datasource": {
 				Description: "Include only resources that match either a resource type or a resource type::regular expression.  See export guide for additional information",
 				Type:        schema.TypeList,
@@ -92,7 +103,31 @@ func ResourceTfExport() *schema.Resource {
 				ConflictsWith: []string{"resource_types", "include_filter_resources"},
 			},
 			"include_state_file": {
-				Description: "Export a 'terraform.tfstate' file along with the config file. This can be used for orgs to begin managing existing resources with terraform. When `false`, GUID fields will be omitted from the config file unless a resource reference can be supplied. In this case, the resource type will need to be included in the `resource_types` array.",
+				Description:   "Export a 'terraform.tfstate' file along with the config file. This can be used for orgs to begin managing existing resources with terraform. When `false`, GUID fields will be omitted from the config file unless a resource reference can be supplied. In this case, the resource type will need to be included in the `resource_types` array.",
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ForceNew:      true,
+				ConflictsWith: []string{"export_import_blocks"},
+			},
+			"export_import_blocks": {
+				Description:   fmt.Sprintf("Export Terraform 1.5+ `import` blocks for every exported resource along with the config. The import blocks are written to '%s' or '%s' when `split_files_by_resource` is enabled. This can be used instead of `include_state_file` to begin managing existing resources in a remote backend with `terraform plan`. As with `include_state_file`, GUID fields that cannot be resolved to a resource reference are kept in the config.", defaultTfHCLImportsFile, defaultTfJSONImportsFile),
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ForceNew:      true,
+				ConflictsWith: []string{"include_state_file"},
+			},
+			"export_moved_blocks": {
+				Description:   fmt.Sprintf("Export Terraform `moved` blocks for every resource whose ID is unchanged since the previous export to the same directory but whose name changed, e.g. because the object was renamed in Genesys Cloud. A '%s' file recording the name of each exported object is written to the directory and kept when the export is replaced. The moved blocks are written to '%s' or '%s' when `split_files_by_resource` is enabled.", defaultResourceNameMapFile, defaultTfHCLMovedFile, defaultTfJSONMovedFile),
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ForceNew:      true,
+				ConflictsWith: []string{"module_grouping"},
+			},
+			"export_dependency_graph": {
+				Description: fmt.Sprintf("Write the dependency graph of the exported resources to '%s' and '%s'. The graph has a node for every exported object, and for every object referenced but not exported, and an edge for every reference between them. Edges are typed by the reference attribute, by the attribute nested in a JSON encoded attribute, or as dependent consumers of flows when `enable_dependency_resolution` is enabled.", defaultDependencyGraphDOTFile, defaultDependencyGraphJSONFile),
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Default:     false,
@@ -112,6 +147,38 @@ func ResourceTfExport() *schema.Resource {
 				Default:     false,
 				ForceNew:    true,
 			},
+			"module_grouping": {
+				Description:   fmt.Sprintf("Export the config as a root module calling one child module per group of resources, written to the '%s' directory. Resources are grouped by their `division` or by `dependency` cluster, where a cluster is a set of resources connected by references. References between modules are passed through module variables and outputs. Resources that do not belong to a division or cluster are exported to the '%s' module.", modulesDirName, commonModuleName),
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.StringInSlice([]string{moduleGroupingDivision, moduleGroupingDependency}, false),
+				ConflictsWith: []string{"include_state_file", "split_files_by_resource", "export_moved_blocks"},
+			},
+			"max_concurrent_reads": {
+				Description:  "Maximum number of objects of each resource type read concurrently. Reads are otherwise only limited by the size of the SDK client pool, set with the provider `token_pool_size`. Lower values reduce the chance of the export being rate limited on large orgs. Set to `0` for no limit.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"max_concurrent_reads_by_type": {
+				Description:  "Maximum number of objects read concurrently for specific resource types, e.g. `{ genesyscloud_flow = 2 }`. Overrides `max_concurrent_reads` for the given resource types. Set to `0` for no limit.",
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ForceNew:     true,
+				Elem:         &schema.Schema{Type: schema.TypeInt},
+				ValidateFunc: validateConcurrentReadsByType,
+			},
+			"max_requests_per_second": {
+				Description:  "Maximum number of requests per second sent to the Genesys Cloud API during the export, shared by all SDK clients of the export. Set to `0` for no limit. Regardless of this setting, all requests of the export are paused when the API responds to one of them with a `Retry-After` header. Other operations of the provider are not limited.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
 			"log_permission_errors": {
 				Description: "Log permission/product issues rather than fail.",
 				Type:        schema.TypeBool,
@@ -133,6 +200,13 @@ func ResourceTfExport() *schema.Resource {
 				Default:     false,
 				ForceNew:    true,
 			},
+			"break_cyclic_deps": {
+				Description: fmt.Sprintf("Break cyclic dependencies between the exported resources, e.g. flows that call each other, so the exported config can be planned by Terraform. For every set of resources that depend on each other, the `depends_on` entries that close a cycle are left out of the config. The cycles, the `depends_on` entries that were left out and the order in which the resources are applied are written to '%s'. Cyclic dependencies do not fail the export when this is enabled, regardless of `ignore_cyclic_deps`.", defaultCyclicDependenciesFile),
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+			},
 			"ignore_cyclic_deps": {
 				Description: "Ignore Cyclic Dependencies when building the flows and do not throw an error",
 				Type:        schema.TypeBool,
@@ -147,6 +221,20 @@ func ResourceTfExport() *schema.Resource {
 				Default:     false,
 				ForceNew:    true,
 			},
+			"incremental": {
+				Description: fmt.Sprintf("Reuse the state of objects that have not changed since the previous export to the same directory. An '%s' file recording the version of each exported object is written to the directory and kept when the export is replaced. Only new objects and objects whose version changed are read again from Genesys Cloud.", defaultExportManifestFile),
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+			},
+			"stable_resource_names": {
+				Description: fmt.Sprintf("Keep the names of exported resources stable across exports to the same directory. A '%s' file recording the original and exported name of each object is written to the directory and kept when the export is replaced. Objects whose name has not changed in Genesys Cloud keep the name they were given by the previous export, and name collisions are resolved with a hash of the object ID.", defaultResourceNameMapFile),
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+			},
 		},
 	}
 }
@@ -157,36 +245,36 @@ func createTfExport(ctx context.Context, d *schema.ResourceData, meta interface{
 	if _, ok := d.GetOk("include_filter_resources"); ok {
 		gre, _ := NewGenesysCloudResourceExporter(ctx, d, meta, IncludeResources)
 		diagErr := gre.Export()
-		if diagErr != nil {
+		if diagErr.HasError() {
 			return diagErr
 		}
 
 		d.SetId(gre.exportDirPath)
-		return nil
+		return diagErr
 	}
 
 	if _, ok := d.GetOk("exclude_filter_resources"); ok {
 		gre, _ := NewGenesysCloudResourceExporter(ctx, d, meta, ExcludeResources)
 		diagErr := gre.Export()
-		if diagErr != nil {
+		if diagErr.HasError() {
 			return diagErr
 		}
 
 		d.SetId(gre.exportDirPath)
-		return nil
+		return diagErr
 	}
 
 	//Dealing with the traditional resource
 	gre, _ := NewGenesysCloudResourceExporter(ctx, d, meta, LegacyInclude)
 	diagErr := gre.Export()
 
-	if diagErr != nil {
+	if diagErr.HasError() {
 		return diagErr
 	}
 
 	d.SetId(gre.exportDirPath)
 
-	return nil
+	return diagErr
 }
 
 // If the output directory doesn't exist or empty, mark the resource for creation.
@@ -205,14 +293,23 @@ func readTfExport(_ context.Context, d *schema.ResourceData, _ interface{}) diag
 }
 
 // Delete everything (files and subdirectories) inside the export directory
-// not including the directory itself
+// not including the directory itself. Incremental exports keep their manifest so the next export can reuse it,
+// and exports with stable resource names or moved blocks keep their name map.
 func deleteTfExport(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
 	exportPath := d.Id()
+	incremental := d.Get("incremental").(bool)
+	keepNameMap := d.Get("stable_resource_names").(bool) || d.Get("export_moved_blocks").(bool)
 	dir, err := os.ReadDir(exportPath)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	for _, d := range dir {
+		if incremental && d.Name() == defaultExportManifestFile {
+			continue
+		}
+		if keepNameMap && d.Name() == defaultResourceNameMapFile {
+			continue
+		}
 		os.RemoveAll(filepath.Join(exportPath, d.Name()))
 	}
 
@@ -0,0 +1,161 @@
+package tfexporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+/*
+This file contains the logic for stable resource names. The names produced by the resource name sanitizer depend on the other objects
+in the export, as colliding names are made unique by appending a hash. An export with stable resource names writes a map of the ID,
+original name and exported name of every object to the export directory. The next export to the same directory gives every object
+whose original name has not changed the name recorded in the map, so its Terraform address stays the same between exports. Objects
+that are new or were renamed in Genesys Cloud are named by the sanitizer, and any remaining collision is resolved with a hash of the
+object ID rather than of the names of the other objects in the export. Exports with moved blocks compare the names recorded in the
+map with the new names to write a moved block for every resource whose name changed.
+*/
+
+type resourceNameMap struct {
+	// Map of resource type to the names of the exported objects by ID
+	Resources map[string]map[string]*resourceNameEntry `json:"resources"`
+}
+
+type resourceNameEntry struct {
+	OriginalName string `json:"original_name"`
+	Name         string `json:"name"`
+}
+
+// readResourceNameMap reads the name map written by a previous export. A missing or unreadable map returns nil.
+func readResourceNameMap(dirPath string) *resourceNameMap {
+	nameMapPath := filepath.Join(dirPath, defaultResourceNameMapFile)
+	data, err := os.ReadFile(nameMapPath)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Printf("Failed to read resource name map %s: %v", nameMapPath, err)
+		}
+		return nil
+	}
+
+	nameMap := &resourceNameMap{}
+	if err := json.Unmarshal(data, nameMap); err != nil {
+		log.Printf("Failed to parse resource name map %s: %v", nameMapPath, err)
+		return nil
+	}
+	return nameMap
+}
+
+// buildResourceNameMap records the names of the objects in the sanitized resource maps of the exporters
+func buildResourceNameMap(exporters map[string]*resourceExporter.ResourceExporter) *resourceNameMap {
+	nameMap := &resourceNameMap{Resources: make(map[string]map[string]*resourceNameEntry)}
+	for resType, exporter := range exporters {
+		if len(exporter.SanitizedResourceMap) == 0 {
+			continue
+		}
+		nameMap.Resources[resType] = make(map[string]*resourceNameEntry)
+		for id, meta := range exporter.SanitizedResourceMap {
+			nameMap.Resources[resType][id] = &resourceNameEntry{OriginalName: meta.OriginalName, Name: meta.Name}
+		}
+	}
+	return nameMap
+}
+
+func (m *resourceNameMap) entry(resType string, id string) *resourceNameEntry {
+	if m == nil {
+		return nil
+	}
+	return m.Resources[resType][id]
+}
+
+// assignNames gives the objects of a resource type the names recorded by the previous export if their original name is unchanged,
+// and makes the names of the remaining objects unique using a hash of their ID
+func (m *resourceNameMap) assignNames(resType string, idMetaMap resourceExporter.ResourceIDMetaMap) {
+	taken := make(map[string]bool)
+	unassigned := make([]string, 0)
+	for _, id := range sortedMapKeys(idMetaMap) {
+		meta := idMetaMap[id]
+		if entry := m.entry(resType, id); entry != nil && entry.OriginalName == meta.OriginalName && !taken[entry.Name] {
+			meta.Name = entry.Name
+			taken[meta.Name] = true
+			continue
+		}
+		unassigned = append(unassigned, id)
+	}
+
+	for _, id := range unassigned {
+		meta := idMetaMap[id]
+		if taken[meta.Name] {
+			meta.Name = meta.Name + "_" + idHash(id)
+		}
+		taken[meta.Name] = true
+	}
+}
+
+// buildMovedBlocks returns a moved block for every exported resource whose name changed since the previous export, and a warning
+// listing the renamed resources that no moved block can be written for
+func (g *GenesysCloudResourceExporter) buildMovedBlocks() ([]movedBlock, diag.Diagnostics) {
+	movedBlocks := make([]movedBlock, 0)
+	for _, resType := range sortedMapKeys(*g.exporters) {
+		exporter := (*g.exporters)[resType]
+		for _, id := range sortedMapKeys(exporter.SanitizedResourceMap) {
+			meta := exporter.SanitizedResourceMap[id]
+			entry := g.previousNameMap.entry(resType, id)
+			if entry == nil || entry.Name == meta.Name || g.isDataSource(resType, meta.Name) {
+				continue
+			}
+			movedBlocks = append(movedBlocks, movedBlock{ResourceType: resType, From: entry.Name, To: meta.Name})
+		}
+	}
+
+	// Terraform chains moved blocks, so a resource cannot be moved to an address another resource is moved from. Moving through a
+	// temporary address would be chained the same way, so these resources are reported instead.
+	fromAddresses := make(map[string]bool)
+	for _, m := range movedBlocks {
+		fromAddresses[m.fromAddress()] = true
+	}
+	result := make([]movedBlock, 0, len(movedBlocks))
+	skipped := make([]string, 0)
+	for _, m := range movedBlocks {
+		if fromAddresses[m.toAddress()] {
+			log.Printf("Not exporting a moved block from %s to %s as %s was also renamed", m.fromAddress(), m.toAddress(), m.toAddress())
+			skipped = append(skipped, fmt.Sprintf("%s to %s", m.fromAddress(), m.toAddress()))
+			continue
+		}
+		result = append(result, m)
+	}
+	if len(skipped) == 0 {
+		return result, nil
+	}
+	return result, diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "Moved blocks were not exported for every renamed resource",
+		Detail: fmt.Sprintf("These resources were renamed to the previous name of another renamed resource, which Terraform would treat as a chain of moves: %s. "+
+			"Use terraform state mv to move them, or Terraform will plan to replace them.", strings.Join(skipped, ", ")),
+	}}
+}
+
+func (m *resourceNameMap) write(dirPath string) diag.Diagnostics {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return diag.Errorf("Failed to encode resource name map as JSON: %v", err)
+	}
+
+	nameMapPath := filepath.Join(dirPath, defaultResourceNameMapFile)
+	log.Printf("Writing resource name map to %s", nameMapPath)
+	return files.WriteToFileIfChanged(data, nameMapPath)
+}
+
+func idHash(id string) string {
+	algorithm := fnv.New32()
+	algorithm.Write([]byte(id))
+	return strconv.FormatUint(uint64(algorithm.Sum32()), 10)
+}
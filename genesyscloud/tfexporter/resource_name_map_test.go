@@ -0,0 +1,95 @@
+package tfexporter
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestUnitResourceNameMapStableNames verifies objects keep the names recorded by the previous export unless they were renamed
+func TestUnitResourceNameMapStableNames(t *testing.T) {
+	dir := t.TempDir()
+	resType := "genesyscloud_routing_queue"
+
+	// Objects with identical names are made unique using a hash of their ID
+	first := resourceExporter.ResourceIDMetaMap{
+		"queue-1": {OriginalName: "Support", Name: "Support"},
+		"queue-2": {OriginalName: "Support", Name: "Support"},
+		"queue-3": {OriginalName: "Sales Queue", Name: "Sales_Queue"},
+	}
+	(*resourceNameMap)(nil).assignNames(resType, first)
+	assert.Equal(t, "Support", first["queue-1"].Name)
+	assert.Equal(t, "Support_"+idHash("queue-2"), first["queue-2"].Name)
+
+	exporters := map[string]*resourceExporter.ResourceExporter{resType: {SanitizedResourceMap: first}}
+	if diagErr := buildResourceNameMap(exporters).write(dir); diagErr != nil {
+		t.Fatalf("failed to write resource name map: %v", diagErr)
+	}
+	previous := readResourceNameMap(dir)
+	if previous == nil {
+		t.Fatal("expected the resource name map to be read")
+	}
+
+	// A new object collides with an existing one, so the sanitizer appends name hashes to both. The existing object keeps its
+	// recorded name, queue-2 was renamed in Genesys Cloud and is named again, and queue-1 was deleted.
+	second := resourceExporter.ResourceIDMetaMap{
+		"queue-2": {OriginalName: "Billing", Name: "Billing"},
+		"queue-3": {OriginalName: "Sales Queue", Name: "Sales_Queue_123"},
+		"queue-4": {OriginalName: "Sales-Queue", Name: "Sales_Queue_456"},
+		"queue-5": {OriginalName: "Support", Name: "Support"},
+	}
+	previous.assignNames(resType, second)
+	assert.Equal(t, "Billing", second["queue-2"].Name)
+	assert.Equal(t, "Sales_Queue", second["queue-3"].Name)
+	assert.Equal(t, "Sales_Queue_456", second["queue-4"].Name)
+	assert.Equal(t, "Support", second["queue-5"].Name)
+}
+
+// TestUnitBuildMovedBlocks verifies moved blocks are built for resources whose name changed and written to the HCL config
+func TestUnitBuildMovedBlocks(t *testing.T) {
+	dir := t.TempDir()
+	g := &GenesysCloudResourceExporter{
+		exporters: &map[string]*resourceExporter.ResourceExporter{
+			"genesyscloud_routing_queue": {SanitizedResourceMap: resourceExporter.ResourceIDMetaMap{
+				"queue-1": {Name: "Billing"},
+				"queue-2": {Name: "Sales"},
+				"queue-3": {Name: "Support"},
+				"queue-4": {Name: "Returns"},
+			}},
+		},
+		previousNameMap: &resourceNameMap{Resources: map[string]map[string]*resourceNameEntry{
+			"genesyscloud_routing_queue": {
+				"queue-1": {Name: "Accounts"},
+				"queue-2": {Name: "Sales"},
+				// queue-3 took the previous name of queue-4, which Terraform would treat as a chain of moves
+				"queue-3": {Name: "Help"},
+				"queue-4": {Name: "Support"},
+			},
+		}},
+	}
+
+	movedBlocks, warnings := g.buildMovedBlocks()
+	assert.Equal(t, []movedBlock{
+		{ResourceType: "genesyscloud_routing_queue", From: "Accounts", To: "Billing"},
+		{ResourceType: "genesyscloud_routing_queue", From: "Support", To: "Returns"},
+	}, movedBlocks)
+	if assert.Len(t, warnings, 1) {
+		assert.Equal(t, diag.Warning, warnings[0].Severity)
+		assert.Contains(t, warnings[0].Detail, "genesyscloud_routing_queue.Help to genesyscloud_routing_queue.Support")
+	}
+
+	hclExporter := NewHClExporter(map[string]resourceHCLBlock{}, nil, nil, movedBlocks, "mypurecloud/genesyscloud", "0.1.0", dir, true, false)
+	if diagErr := hclExporter.exportHCLConfig(); diagErr != nil {
+		t.Fatalf("failed to export HCL: %v", diagErr)
+	}
+	moved, err := os.ReadFile(filepath.Join(dir, defaultTfHCLMovedFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Contains(t, string(moved), "moved {\n  from = genesyscloud_routing_queue.Accounts\n  to   = genesyscloud_routing_queue.Billing\n}")
+}
@@ -0,0 +1,303 @@
+package tfexporter
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+/*
+This file contains the logic for the state filters of an export. Unlike the include and exclude filters, which only match on the
+resource type and name, state filters are evaluated against the state read for every object before the HCL or JSON config is generated.
+A filter is either a predicate that applies to every resource type, e.g. modified_after=2026-01-01, or a comma separated list of
+predicates for a single resource type, e.g. genesyscloud_routing_queue[division=Sales,enable_transcription=true]. An object is only
+exported if it matches every filter that applies to it.
+*/
+
+const (
+	// Matches the division_id of an object against the ID or name of a division
+	stateFilterDivision = "division"
+
+	// Matches objects modified on or after a date. Objects whose exporter does not report a modified date are kept and reported
+	// in a warning.
+	stateFilterModifiedAfter = "modified_after"
+)
+
+var stateFilterRegex = regexp.MustCompile(`^(genesyscloud_\w+)\[(.+)\]$`)
+
+type stateFilter struct {
+	ResourceType string
+	predicates   []statePredicate
+}
+
+type statePredicate struct {
+	Attribute string
+	Value     string
+
+	modifiedAfter time.Time
+}
+
+// parseStateFilter parses a filter in the form resource_type[attribute=value,...] or attribute=value
+func parseStateFilter(filter string) (*stateFilter, error) {
+	result := &stateFilter{}
+	predicates := filter
+	if match := stateFilterRegex.FindStringSubmatch(filter); match != nil {
+		result.ResourceType = match[1]
+		predicates = match[2]
+	} else if strings.ContainsAny(filter, "[]") {
+		return nil, fmt.Errorf("state filter %s must be in the form resource_type[attribute=value]", filter)
+	}
+
+	for _, p := range strings.Split(predicates, ",") {
+		attribute, value, found := strings.Cut(strings.TrimSpace(p), "=")
+		if !found || attribute == "" || value == "" {
+			return nil, fmt.Errorf("state filter predicate %q of %s must be in the form attribute=value", p, filter)
+		}
+
+		predicate := statePredicate{Attribute: attribute, Value: value}
+		if attribute == stateFilterModifiedAfter {
+			date, err := parseStateFilterDate(value)
+			if err != nil {
+				return nil, fmt.Errorf("state filter %s has an invalid date: %v", filter, err)
+			}
+			predicate.modifiedAfter = date
+		}
+		result.predicates = append(result.predicates, predicate)
+	}
+	return result, nil
+}
+
+func parseStateFilterDate(value string) (time.Time, error) {
+	if date, err := time.Parse(time.DateOnly, value); err == nil {
+		return date, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
+func parseStateFilters(filters []string) ([]*stateFilter, error) {
+	result := make([]*stateFilter, 0, len(filters))
+	for _, f := range filters {
+		filter, err := parseStateFilter(f)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, filter)
+	}
+	return result, nil
+}
+
+// validateStateFilter is the schema validation function for a single state filter
+func validateStateFilter(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	if _, err := parseStateFilter(v); err != nil {
+		errors = append(errors, err)
+	}
+	return warnings, errors
+}
+
+// stateAttribute returns the attribute of the state that a predicate is evaluated against
+func (p statePredicate) stateAttribute() string {
+	if p.Attribute == stateFilterDivision {
+		return "division_id"
+	}
+	return p.Attribute
+}
+
+// appliesTo returns true if the predicate can be evaluated for a resource type. Predicates without a resource type only apply
+// to the resource types whose schema has the attribute.
+func (p statePredicate) appliesTo(filter *stateFilter, resType string) bool {
+	if filter.ResourceType != "" {
+		return filter.ResourceType == resType
+	}
+	if p.Attribute == stateFilterModifiedAfter {
+		return true
+	}
+	res := providerResources[resType]
+	if res == nil {
+		return false
+	}
+	_, ok := res.Schema[strings.Split(p.stateAttribute(), ".")[0]]
+	return ok
+}
+
+// matches evaluates the predicate against the state of an object. List attributes match if any of their elements match.
+func (p statePredicate) matches(state *terraform.InstanceState, resMeta *resourceExporter.ResourceMeta, divisionIds map[string][]string) bool {
+	switch p.Attribute {
+	case stateFilterModifiedAfter:
+		if resMeta == nil || resMeta.DateModified == nil {
+			return true
+		}
+		return !resMeta.DateModified.Before(p.modifiedAfter)
+	case stateFilterDivision:
+		divisionId := state.Attributes["division_id"]
+		if divisionId == p.Value {
+			return true
+		}
+		for _, id := range divisionIds[p.Value] {
+			if id == divisionId {
+				return true
+			}
+		}
+		return false
+	}
+
+	attribute := p.stateAttribute()
+	if value, ok := state.Attributes[attribute]; ok {
+		return value == p.Value
+	}
+	for key, value := range state.Attributes {
+		if strings.HasPrefix(key, attribute+".") && !strings.HasSuffix(key, ".#") && !strings.HasSuffix(key, ".%") && value == p.Value {
+			return true
+		}
+	}
+	return false
+}
+
+// applyStateFilters removes the objects that do not match the state filters from the export. A warning lists the resource types
+// that the modified_after filter could not be applied to.
+func (g *GenesysCloudResourceExporter) applyStateFilters() diag.Diagnostics {
+	if len(g.stateFilters) == 0 {
+		return nil
+	}
+
+	for _, filter := range g.stateFilters {
+		if filter.ResourceType == "" {
+			continue
+		}
+		res := providerResources[filter.ResourceType]
+		if res == nil {
+			return diag.Errorf("State filter resource type %s is not defined", filter.ResourceType)
+		}
+		for _, p := range filter.predicates {
+			if p.Attribute == stateFilterModifiedAfter {
+				continue
+			}
+			if _, ok := res.Schema[strings.Split(p.stateAttribute(), ".")[0]]; !ok {
+				return diag.Errorf("State filter attribute %s is not defined for %s", p.Attribute, filter.ResourceType)
+			}
+		}
+	}
+
+	divisionIds, diagErr := g.stateFilterDivisionIds()
+	if diagErr != nil {
+		return diagErr
+	}
+
+	filtered := make([]resourceExporter.ResourceInfo, 0, len(g.resources))
+	unmodifiedTypes := make(map[string]bool)
+	for _, resource := range g.resources {
+		var resMeta *resourceExporter.ResourceMeta
+		exporter := (*g.exporters)[resource.Type]
+		if exporter != nil {
+			resMeta = exporter.SanitizedResourceMap[resource.State.ID]
+		}
+		if (resMeta == nil || resMeta.DateModified == nil) && g.hasModifiedAfterFilter(resource.Type) {
+			unmodifiedTypes[resource.Type] = true
+		}
+
+		if g.matchesStateFilters(resource, resMeta, divisionIds) {
+			filtered = append(filtered, resource)
+			continue
+		}
+
+		log.Printf("Excluding %s %s from the export as it does not match the state filters", resource.Type, resource.Name)
+		g.summary.recordSkipped(skippedResource{ResourceType: resource.Type, Id: resource.State.ID, Name: resource.Name, Reason: skipReasonStateFilters})
+		if exporter != nil {
+			delete(exporter.SanitizedResourceMap, resource.State.ID)
+		}
+	}
+
+	log.Printf("State filters excluded %d of %d objects from the export", len(g.resources)-len(filtered), len(g.resources))
+	g.resources = filtered
+
+	if len(unmodifiedTypes) == 0 {
+		return nil
+	}
+	resTypes := make([]string, 0, len(unmodifiedTypes))
+	for resType := range unmodifiedTypes {
+		resTypes = append(resTypes, resType)
+	}
+	sort.Strings(resTypes)
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("State filter %s was not applied to every resource type", stateFilterModifiedAfter),
+		Detail:   fmt.Sprintf("The exporters of %s do not report when their objects were modified, so their objects were exported regardless of the %s filter.", strings.Join(resTypes, ", "), stateFilterModifiedAfter),
+	}}
+}
+
+// hasModifiedAfterFilter returns true if a modified_after predicate applies to a resource type
+func (g *GenesysCloudResourceExporter) hasModifiedAfterFilter(resType string) bool {
+	for _, filter := range g.stateFilters {
+		for _, p := range filter.predicates {
+			if p.Attribute == stateFilterModifiedAfter && p.appliesTo(filter, resType) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (g *GenesysCloudResourceExporter) matchesStateFilters(resource resourceExporter.ResourceInfo, resMeta *resourceExporter.ResourceMeta, divisionIds map[string][]string) bool {
+	for _, filter := range g.stateFilters {
+		for _, p := range filter.predicates {
+			if p.appliesTo(filter, resource.Type) && !p.matches(resource.State, resMeta, divisionIds) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// stateFilterDivisionIds maps the division names used by the state filters to their IDs. Names are looked up in the exported
+// genesyscloud_auth_division objects first, and the divisions of the org are only listed if a name is not found there.
+func (g *GenesysCloudResourceExporter) stateFilterDivisionIds() (map[string][]string, diag.Diagnostics) {
+	names := make(map[string]bool)
+	for _, filter := range g.stateFilters {
+		for _, p := range filter.predicates {
+			if p.Attribute == stateFilterDivision {
+				names[p.Value] = true
+			}
+		}
+	}
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	divisionIds := make(map[string][]string)
+	for _, resource := range g.resources {
+		if resource.Type == "genesyscloud_auth_division" && names[resource.State.Attributes["name"]] {
+			name := resource.State.Attributes["name"]
+			divisionIds[name] = append(divisionIds[name], resource.State.ID)
+		}
+	}
+	if len(divisionIds) == len(names) {
+		return divisionIds, nil
+	}
+
+	exporter := resourceExporter.GetResourceExporters()["genesyscloud_auth_division"]
+	if exporter == nil {
+		return divisionIds, nil
+	}
+	divisions, diagErr := exporter.GetResourcesFunc(provider.WithClientPool(g.ctx, g.meta))
+	if diagErr != nil {
+		return nil, diagErr
+	}
+	for id, meta := range divisions {
+		if names[meta.Name] && !lists.ItemInSlice(id, divisionIds[meta.Name]) {
+			divisionIds[meta.Name] = append(divisionIds[meta.Name], id)
+		}
+	}
+	return divisionIds, nil
+}
@@ -0,0 +1,112 @@
+package tfexporter
+
+import (
+	"testing"
+	"time"
+
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestUnitParseStateFilter verifies typed and untyped state filters are parsed and invalid filters are rejected
+func TestUnitParseStateFilter(t *testing.T) {
+	filter, err := parseStateFilter("genesyscloud_routing_queue[division=Sales, enable_transcription=true]")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "genesyscloud_routing_queue", filter.ResourceType)
+	assert.Equal(t, []statePredicate{{Attribute: "division", Value: "Sales"}, {Attribute: "enable_transcription", Value: "true"}}, filter.predicates)
+
+	filter, err = parseStateFilter("modified_after=2026-01-01")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Empty(t, filter.ResourceType)
+	assert.Equal(t, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), filter.predicates[0].modifiedAfter)
+
+	for _, invalid := range []string{
+		"genesyscloud_routing_queue",
+		"genesyscloud_routing_queue[division]",
+		"genesyscloud_routing_queue[division=Sales",
+		"modified_after=yesterday",
+		"state=",
+	} {
+		if _, err := parseStateFilter(invalid); err == nil {
+			t.Errorf("expected an error parsing state filter %s", invalid)
+		}
+	}
+}
+
+// TestUnitApplyStateFilters verifies objects that do not match the state filters are removed from the export
+func TestUnitApplyStateFilters(t *testing.T) {
+	modified := func(date string) *time.Time {
+		d, _ := time.Parse(time.DateOnly, date)
+		return &d
+	}
+	resourceInfo := func(resType string, name string, id string, attributes map[string]string) resourceExporter.ResourceInfo {
+		return resourceExporter.ResourceInfo{Type: resType, Name: name, State: &terraform.InstanceState{ID: id, Attributes: attributes}}
+	}
+
+	queueExporter := &resourceExporter.ResourceExporter{SanitizedResourceMap: resourceExporter.ResourceIDMetaMap{
+		"queue-1": {Name: "sales_recent", DateModified: modified("2026-03-01")},
+		"queue-2": {Name: "sales_old", DateModified: modified("2025-06-01")},
+		"queue-3": {Name: "support_recent", DateModified: modified("2026-03-01")},
+	}}
+	userExporter := &resourceExporter.ResourceExporter{SanitizedResourceMap: resourceExporter.ResourceIDMetaMap{
+		"user-1": {Name: "active"},
+		"user-2": {Name: "inactive"},
+	}}
+
+	g := &GenesysCloudResourceExporter{
+		exporters: &map[string]*resourceExporter.ResourceExporter{
+			"genesyscloud_routing_queue": queueExporter,
+			"genesyscloud_user":          userExporter,
+		},
+		resources: []resourceExporter.ResourceInfo{
+			resourceInfo("genesyscloud_auth_division", "Sales", "div-sales", map[string]string{"name": "Sales"}),
+			resourceInfo("genesyscloud_routing_queue", "sales_recent", "queue-1", map[string]string{"division_id": "div-sales"}),
+			resourceInfo("genesyscloud_routing_queue", "sales_old", "queue-2", map[string]string{"division_id": "div-sales"}),
+			resourceInfo("genesyscloud_routing_queue", "support_recent", "queue-3", map[string]string{"division_id": "div-support"}),
+			resourceInfo("genesyscloud_user", "active", "user-1", map[string]string{"state": "active", "division_id": "div-support"}),
+			resourceInfo("genesyscloud_user", "inactive", "user-2", map[string]string{"state": "inactive", "division_id": "div-sales"}),
+		},
+	}
+
+	var err error
+	g.stateFilters, err = parseStateFilters([]string{
+		"genesyscloud_routing_queue[division=Sales]",
+		"genesyscloud_user[state=active]",
+		"modified_after=2026-01-01",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diagErr := g.applyStateFilters()
+	if diagErr.HasError() {
+		t.Fatalf("unexpected error applying state filters: %v", diagErr)
+	}
+	// Objects whose exporter does not report a modified date are kept and their types are reported
+	if assert.Len(t, diagErr, 1) {
+		assert.Equal(t, diag.Warning, diagErr[0].Severity)
+		assert.Contains(t, diagErr[0].Detail, "genesyscloud_auth_division, genesyscloud_user do not report")
+	}
+
+	exported := make([]string, 0)
+	for _, resource := range g.resources {
+		exported = append(exported, resource.Type+"."+resource.Name)
+	}
+	assert.ElementsMatch(t, []string{
+		"genesyscloud_auth_division.Sales",
+		"genesyscloud_routing_queue.sales_recent",
+		"genesyscloud_user.active",
+	}, exported)
+	assert.Len(t, queueExporter.SanitizedResourceMap, 1)
+	assert.Contains(t, userExporter.SanitizedResourceMap, "user-1")
+
+	g.stateFilters, _ = parseStateFilters([]string{"genesyscloud_user[not_an_attribute=x]"})
+	assert.True(t, g.applyStateFilters().HasError())
+}
@@ -0,0 +1,260 @@
+package tfexporter
+
+import (
+	"context"
+	"flag"
+	"log"
+	"strconv"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+/*
+This file contains the logic needed to run the exporter as a standalone command (e.g. `terraform-provider-genesyscloud export ...`)
+rather than as a genesyscloud_tf_export resource inside a terraform apply. The command line flags map one to one onto the attributes of
+the genesyscloud_tf_export resource, so the same validation and export code paths are used by both.
+It also contains the drift command (e.g. `terraform-provider-genesyscloud drift -state terraform.tfstate`) which reports the differences
+between an existing state file and the live org.
+*/
+
+// ExportCommandName is the sub command used to invoke the exporter from the provider binary
+const ExportCommandName = "export"
+
+// DriftCommandName is the sub command used to build a drift report from the provider binary
+const DriftCommandName = "drift"
+
+// stringListFlag is a repeatable command line flag used for the list attributes of the export resource
+type stringListFlag []string
+
+func (s *stringListFlag) String() string {
+	return strings.Join(*s, ",")
+}
+
+func (s *stringListFlag) Set(value string) error {
+	*s = append(*s, value)
+	return nil
+}
+
+type exportCommandFlags struct {
+	directory                  string
+	includeFilterResources     stringListFlag
+	excludeFilterResources     stringListFlag
+	replaceWithDatasource      stringListFlag
+	stateFilters               stringListFlag
+	excludeAttributes          stringListFlag
+	includeStateFile           bool
+	exportImportBlocks         bool
+	exportAsHCL                bool
+	splitFilesByResource       bool
+	logPermissionErrors        bool
+	enableDependencyResolution bool
+	ignoreCyclicDeps           bool
+	breakCyclicDeps            bool
+	compress                   bool
+	incremental                bool
+	stableResourceNames        bool
+	exportMovedBlocks          bool
+	exportDependencyGraph      bool
+	maxConcurrentReads         int
+	maxConcurrentReadsByType   stringListFlag
+	maxRequestsPerSecond       int
+	moduleGrouping             string
+}
+
+// RunExportCommand parses the export command line arguments, configures the provider from its environment variables
+// (e.g. GENESYSCLOUD_OAUTHCLIENT_ID, GENESYSCLOUD_REGION) and runs an export without a terraform workspace.
+func RunExportCommand(ctx context.Context, version string, args []string) diag.Diagnostics {
+	d, diagErr := newExportCommandResourceData(args)
+	if diagErr.HasError() {
+		return diagErr
+	}
+
+	meta, configureDiagErr := configureExportCommandProvider(ctx, version)
+	diagErr = append(diagErr, configureDiagErr...)
+	if diagErr.HasError() {
+		return diagErr
+	}
+
+	diagErr = append(diagErr, createTfExport(ctx, d, meta)...)
+	if diagErr.HasError() {
+		return diagErr
+	}
+
+	log.Printf("Export written to %s", d.Id())
+	return diagErr
+}
+
+// newExportCommandResourceData converts the command line arguments into the resource data of a genesyscloud_tf_export
+// resource. The raw config is validated against the resource schema so conflicting or unknown filters are rejected.
+func newExportCommandResourceData(args []string) (*schema.ResourceData, diag.Diagnostics) {
+	var f exportCommandFlags
+	flagSet := flag.NewFlagSet(ExportCommandName, flag.ContinueOnError)
+	flagSet.StringVar(&f.directory, "directory", "./genesyscloud", "Directory where the config and state files will be exported.")
+	flagSet.Var(&f.includeFilterResources, "include-filter-resources", "Include only resources that match either a resource type or a resource type::regular expression. May be repeated.")
+	flagSet.Var(&f.excludeFilterResources, "exclude-filter-resources", "Exclude resources that match either a resource type or a resource type::regular expression. May be repeated.")
+	flagSet.Var(&f.stateFilters, "state-filter", "Export only objects whose state matches a filter such as resource_type[attribute=value] or modified_after=YYYY-MM-DD. May be repeated.")
+	flagSet.Var(&f.replaceWithDatasource, "replace-with-datasource", "Export resources matching a resource type::regular expression as data sources. May be repeated.")
+	flagSet.Var(&f.excludeAttributes, "exclude-attributes", "Attribute to exclude from the config in the form {resource_name}.{attribute}. May be repeated.")
+	flagSet.BoolVar(&f.includeStateFile, "include-state-file", false, "Export a 'terraform.tfstate' file along with the config file.")
+	flagSet.BoolVar(&f.exportImportBlocks, "export-import-blocks", false, "Export Terraform 1.5+ import blocks for every exported resource along with the config.")
+	flagSet.BoolVar(&f.exportMovedBlocks, "export-moved-blocks", false, "Export moved blocks for resources whose name changed since the previous export to the same directory.")
+	flagSet.BoolVar(&f.exportDependencyGraph, "export-dependency-graph", false, "Write the dependency graph of the exported resources as Graphviz DOT and JSON.")
+	flagSet.BoolVar(&f.exportAsHCL, "export-as-hcl", false, "Export the config as HCL.")
+	flagSet.BoolVar(&f.splitFilesByResource, "split-files-by-resource", false, "Split export files by resource type.")
+	flagSet.BoolVar(&f.logPermissionErrors, "log-permission-errors", false, "Log permission/product issues rather than fail.")
+	flagSet.BoolVar(&f.enableDependencyResolution, "enable-dependency-resolution", false, "Resolve and export the dependencies of the included resources.")
+	flagSet.BoolVar(&f.ignoreCyclicDeps, "ignore-cyclic-deps", true, "Ignore cyclic dependencies when building the flows and do not throw an error.")
+	flagSet.BoolVar(&f.breakCyclicDeps, "break-cyclic-deps", false, "Leave depends_on entries out of the config to break cyclic dependencies and report them.")
+	flagSet.BoolVar(&f.compress, "compress", false, "Compress exported results using zip format.")
+	flagSet.StringVar(&f.moduleGrouping, "module-grouping", "", "Group the exported resources into child modules by 'division' or 'dependency' cluster.")
+	flagSet.IntVar(&f.maxConcurrentReads, "max-concurrent-reads", 0, "Maximum number of objects of each resource type read concurrently. 0 for no limit.")
+	flagSet.Var(&f.maxConcurrentReadsByType, "max-concurrent-reads-by-type", "Maximum number of objects of a resource type read concurrently in the form {resource_type}={number}. May be repeated.")
+	flagSet.IntVar(&f.maxRequestsPerSecond, "max-requests-per-second", 0, "Maximum number of requests per second sent to the Genesys Cloud API. 0 for no limit.")
+	flagSet.BoolVar(&f.incremental, "incremental", false, "Reuse the state of objects that have not changed since the previous export to the same directory.")
+	flagSet.BoolVar(&f.stableResourceNames, "stable-resource-names", false, "Keep the names of exported resources stable across exports to the same directory.")
+
+	if err := flagSet.Parse(args); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	if flagSet.NArg() > 0 {
+		return nil, diag.Errorf("unexpected arguments for the %s command: %v", ExportCommandName, flagSet.Args())
+	}
+
+	config := map[string]interface{}{
+		"directory":                    f.directory,
+		"include_state_file":           f.includeStateFile,
+		"export_import_blocks":         f.exportImportBlocks,
+		"export_moved_blocks":          f.exportMovedBlocks,
+		"export_dependency_graph":      f.exportDependencyGraph,
+		"export_as_hcl":                f.exportAsHCL,
+		"split_files_by_resource":      f.splitFilesByResource,
+		"log_permission_errors":        f.logPermissionErrors,
+		"enable_dependency_resolution": f.enableDependencyResolution,
+		"ignore_cyclic_deps":           f.ignoreCyclicDeps,
+		"break_cyclic_deps":            f.breakCyclicDeps,
+		"compress":                     f.compress,
+		"incremental":                  f.incremental,
+		"max_concurrent_reads":         f.maxConcurrentReads,
+		"max_requests_per_second":      f.maxRequestsPerSecond,
+		"stable_resource_names":        f.stableResourceNames,
+	}
+	if f.moduleGrouping != "" {
+		config["module_grouping"] = f.moduleGrouping
+	}
+	listAttrs := map[string]stringListFlag{
+		"include_filter_resources": f.includeFilterResources,
+		"exclude_filter_resources": f.excludeFilterResources,
+		"replace_with_datasource":  f.replaceWithDatasource,
+		"state_filters":            f.stateFilters,
+		"exclude_attributes":       f.excludeAttributes,
+	}
+	for attr, values := range listAttrs {
+		if len(values) > 0 {
+			config[attr] = lists.StringListToInterfaceList(values)
+		}
+	}
+	if len(f.maxConcurrentReadsByType) > 0 {
+		concurrencyByType := make(map[string]interface{})
+		for _, value := range f.maxConcurrentReadsByType {
+			resType, concurrency, found := strings.Cut(value, "=")
+			n, err := strconv.Atoi(concurrency)
+			if !found || err != nil {
+				return nil, diag.Errorf("invalid value %s for -max-concurrent-reads-by-type. Expected {resource_type}={number}", value)
+			}
+			concurrencyByType[resType] = n
+		}
+		config["max_concurrent_reads_by_type"] = concurrencyByType
+	}
+
+	// Only validate the attributes that differ from their defaults, as terraform would only see those in a resource block
+	exportResource := ResourceTfExport()
+	rawConfig := make(map[string]interface{})
+	for attr, value := range config {
+		if value != exportResource.Schema[attr].Default {
+			rawConfig[attr] = value
+		}
+	}
+	diagErr := exportResource.Validate(terraform.NewResourceConfigRaw(rawConfig))
+	if diagErr.HasError() {
+		return nil, diagErr
+	}
+
+	d := exportResource.Data(nil)
+	for attr, value := range config {
+		if err := d.Set(attr, value); err != nil {
+			return nil, diag.Errorf("failed to set %s for the %s command: %v", attr, ExportCommandName, err)
+		}
+	}
+	return d, diagErr
+}
+
+// RunDriftCommand refreshes every object in a terraform.tfstate file against the live org and writes a drift report
+func RunDriftCommand(ctx context.Context, version string, args []string) diag.Diagnostics {
+	var (
+		stateFilePath      string
+		directory          string
+		includeUnmanaged   bool
+		failOnDrift        bool
+		maxConcurrentReads int
+	)
+	flagSet := flag.NewFlagSet(DriftCommandName, flag.ContinueOnError)
+	flagSet.StringVar(&stateFilePath, "state", "./terraform.tfstate", "Path of the terraform.tfstate file to check for drift.")
+	flagSet.StringVar(&directory, "directory", ".", "Directory where the drift report files will be written.")
+	flagSet.BoolVar(&includeUnmanaged, "include-unmanaged", true, "Report objects of the resource types in the state that exist in the org but are not managed by the state.")
+	flagSet.BoolVar(&failOnDrift, "fail-on-drift", false, "Return an error if any drift is found.")
+	flagSet.IntVar(&maxConcurrentReads, "max-concurrent-reads", 0, "Maximum number of objects read concurrently. 0 to read as many objects at once as the provider token_pool_size.")
+
+	if err := flagSet.Parse(args); err != nil {
+		return diag.FromErr(err)
+	}
+	if flagSet.NArg() > 0 {
+		return diag.Errorf("unexpected arguments for the %s command: %v", DriftCommandName, flagSet.Args())
+	}
+
+	meta, diagErr := configureExportCommandProvider(ctx, version)
+	if diagErr.HasError() {
+		return diagErr
+	}
+
+	resources, _ := registrar.GetResources()
+	report, reportDiagErr := BuildDriftReport(ctx, stateFilePath, resources, includeUnmanaged, maxConcurrentReads, meta)
+	diagErr = append(diagErr, reportDiagErr...)
+	if diagErr.HasError() {
+		return diagErr
+	}
+	diagErr = append(diagErr, WriteDriftReport(report, directory)...)
+	if diagErr.HasError() {
+		return diagErr
+	}
+
+	log.Printf("Drift report: %d changed, %d deleted in org, %d unmanaged, %d errors", len(report.Changed), len(report.Deleted), len(report.Unmanaged), len(report.Errors))
+	if failOnDrift && report.HasDrift() {
+		return append(diagErr, diag.Errorf("drift found between %s and the org. See the report in %s", stateFilePath, directory)...)
+	}
+	return diagErr
+}
+
+// configureExportCommandProvider configures a provider instance the same way terraform would for an empty provider block,
+// so all of the credentials and settings are read from the GENESYSCLOUD_* environment variables.
+func configureExportCommandProvider(ctx context.Context, version string) (interface{}, diag.Diagnostics) {
+	resources, dataSources := registrar.GetResources()
+	p := provider.New(version, resources, dataSources)()
+
+	diagErr := p.Configure(ctx, terraform.NewResourceConfigRaw(map[string]interface{}{}))
+	if diagErr.HasError() {
+		return nil, diagErr
+	}
+
+	meta, ok := p.Meta().(*provider.ProviderMeta)
+	if !ok || meta == nil {
+		return nil, diag.Errorf("failed to configure the genesyscloud provider for the %s command", ExportCommandName)
+	}
+	return meta, diagErr
+}
@@ -0,0 +1,69 @@
+package tfexporter
+
+import (
+	"testing"
+
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+)
+
+// TestUnitExportCommandResourceData verifies the export command flags are converted into the equivalent tf_export resource data
+func TestUnitExportCommandResourceData(t *testing.T) {
+	directory := t.TempDir()
+	d, diagErr := newExportCommandResourceData([]string{
+		"-directory", directory,
+		"-include-filter-resources", "genesyscloud_user",
+		"-include-filter-resources", "genesyscloud_routing_queue::^prod",
+		"-export-as-hcl",
+		"-split-files-by-resource",
+		"-max-concurrent-reads", "5",
+		"-max-concurrent-reads-by-type", "genesyscloud_flow=2",
+	})
+	if diagErr.HasError() {
+		t.Fatalf("unexpected error building resource data: %v", diagErr)
+	}
+
+	if got := d.Get("directory").(string); got != directory {
+		t.Errorf("expected directory %s, got %s", directory, got)
+	}
+	filters := lists.InterfaceListToStrings(d.Get("include_filter_resources").([]interface{}))
+	if !lists.AreEquivalent(filters, []string{"genesyscloud_user", "genesyscloud_routing_queue::^prod"}) {
+		t.Errorf("unexpected include filters %v", filters)
+	}
+	if !d.Get("export_as_hcl").(bool) || !d.Get("split_files_by_resource").(bool) {
+		t.Errorf("expected export_as_hcl and split_files_by_resource to be set")
+	}
+	if !d.Get("ignore_cyclic_deps").(bool) {
+		t.Errorf("expected ignore_cyclic_deps to default to true")
+	}
+	if d.Get("include_state_file").(bool) {
+		t.Errorf("expected include_state_file to default to false")
+	}
+	if d.Get("max_concurrent_reads").(int) != 5 || d.Get("max_concurrent_reads_by_type.genesyscloud_flow").(int) != 2 {
+		t.Errorf("expected max_concurrent_reads to be 5 and 2 for genesyscloud_flow")
+	}
+}
+
+// TestUnitExportCommandInvalidFlags verifies the export command rejects configurations the tf_export resource would reject
+func TestUnitExportCommandInvalidFlags(t *testing.T) {
+	testCases := map[string][]string{
+		"conflicting filters":          {"-include-filter-resources", "genesyscloud_user", "-exclude-filter-resources", "genesyscloud_group"},
+		"unknown type":                 {"-include-filter-resources", "genesyscloud_not_a_resource"},
+		"unknown flag":                 {"-not-a-flag"},
+		"positional argument":          {"genesyscloud_user"},
+		"state file and import blocks": {"-include-state-file", "-export-import-blocks"},
+		"unknown module grouping":      {"-module-grouping", "team"},
+		"split files and modules":      {"-module-grouping", "division", "-split-files-by-resource"},
+		"invalid state filter":         {"-state-filter", "genesyscloud_user[state]"},
+		"moved blocks and modules":     {"-export-moved-blocks", "-module-grouping", "division"},
+		"invalid concurrency by type":  {"-max-concurrent-reads-by-type", "genesyscloud_flow"},
+		"negative concurrency by type": {"-max-concurrent-reads-by-type", "genesyscloud_flow=-1"},
+		"concurrency of unknown type":  {"-max-concurrent-reads-by-type", "flow=2"},
+		"negative requests per second": {"-max-requests-per-second", "-1"},
+	}
+
+	for name, args := range testCases {
+		if _, diagErr := newExportCommandResourceData(args); !diagErr.HasError() {
+			t.Errorf("%s: expected an error for args %v", name, args)
+		}
+	}
+}
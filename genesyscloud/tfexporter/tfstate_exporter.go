@@ -115,9 +115,10 @@ func (t *TFStateFileWriter) writeTfState() diag.Diagnostics {
 	return nil
 }
 
-func generateTfVarsContent(vars map[string]interface{}) string {
+func generateTfVarsContent(vars map[string]interface{}, sorted bool) string {
 	tfVarsContent := ""
-	for k, v := range vars {
+	for _, k := range mapKeys(vars, sorted) {
+		v := vars[k]
 		vStr := v
 		if v == nil {
 			vStr = "null"
@@ -126,7 +127,7 @@ func generateTfVarsContent(vars map[string]interface{}) string {
 		} else if m, ok := v.(map[string]interface{}); ok {
 			vStr = fmt.Sprintf(`{
 	%s
-}`, strings.Replace(generateTfVarsContent(m), "\n", "\n\t", -1))
+}`, strings.Replace(generateTfVarsContent(m, sorted), "\n", "\n\t", -1))
 		}
 		newLine := ""
 		if tfVarsContent != "" {
@@ -138,11 +139,11 @@ func generateTfVarsContent(vars map[string]interface{}) string {
 	return tfVarsContent
 }
 
-func writeTfVars(tfVars map[string]interface{}, path string) diag.Diagnostics {
-	tfVarsStr := generateTfVarsContent(tfVars)
+func writeTfVars(tfVars map[string]interface{}, path string, incremental bool) diag.Diagnostics {
+	tfVarsStr := generateTfVarsContent(tfVars, incremental)
 	tfVarsStr = fmt.Sprintf("// This file has been autogenerated. The following properties could not be retrieved from the API or would not make sense in a different org e.g. Edge IDs"+
 		"\n// The variables contained in this file have been given default values and should be edited as necessary\n\n%s", tfVarsStr)
 
 	log.Printf("Writing export tfvars file to %s", path)
-	return files.WriteToFile([]byte(tfVarsStr), path)
+	return writeExportFile([]byte(tfVarsStr), path, incremental)
 }
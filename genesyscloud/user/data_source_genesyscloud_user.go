@@ -16,10 +16,6 @@ import (
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
 )
 
-var (
-	dataSourceUserCache *rc.DataSourceCache
-)
-
 func DataSourceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sdkConfig := m.(*provider.ProviderMeta).ClientConfig
 	key := ""
@@ -36,11 +32,8 @@ func DataSourceUserRead(ctx context.Context, d *schema.ResourceData, m interface
 		return util.BuildDiagnosticError(resourceName, "no user search field specified", nil)
 	}
 
-	if dataSourceUserCache == nil {
-		dataSourceUserCache = rc.NewDataSourceCache(sdkConfig, hydrateUserCacheFn, getUserByNameFn)
-	}
-
-	userId, err := rc.RetrieveId(dataSourceUserCache, resourceName, key, ctx)
+	cache := rc.GetDataSourceCache(sdkConfig, resourceName, newUserDataSourceCache)
+	userId, err := rc.RetrieveId(cache, resourceName, key, m.(*provider.ProviderMeta).DataSourceCache, ctx)
 	if err != nil {
 		return err
 	}
@@ -129,3 +122,10 @@ func getUserByNameFn(c *rc.DataSourceCache, searchField string, ctx context.Cont
 	return userId, diag
 
 }
+
+func newUserDataSourceCache(sdkConfig *platformclientv2.Configuration) *rc.DataSourceCache {
+	cache := rc.NewDataSourceCache(sdkConfig, hydrateUserCacheFn, getUserByNameFn)
+	cache.RevalidatePath = "/api/v2/users/{id}"
+	cache.RevalidateFields = []string{"name", "email"}
+	return cache
+}
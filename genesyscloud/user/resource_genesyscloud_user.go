@@ -41,12 +41,25 @@ func GetAllUsers(ctx context.Context, sdkConfig *platformclientv2.Configuration)
 
 	// Add resources to metamap
 	for _, user := range *users {
+		// The version of a user doesn't change with its skills, languages or utilization, so users are read on every incremental export
 		resources[*user.Id] = &resourceExporter.ResourceMeta{Name: *user.Email}
 	}
 
 	return resources, nil
 }
 
+// lookupUserReference looks up a user referenced by another resource
+func lookupUserReference(ctx context.Context, clientConfig *platformclientv2.Configuration, id string) (string, bool, diag.Diagnostics) {
+	_, resp, err := getUserProxy(clientConfig).getUserById(ctx, id, nil, "")
+	if err != nil {
+		if util.IsStatus404(resp) {
+			return "", false, nil
+		}
+		return "", false, util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to get user %s error: %s", id, err), resp)
+	}
+	return "", true, nil
+}
+
 func createUser(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
 	proxy := getUserProxy(sdkConfig)
@@ -123,7 +136,7 @@ func createUser(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 		}
 	}
 
-	diagErr := executeAllUpdates(d, proxy, sdkConfig, false)
+	diagErr := executeAllUpdates(ctx, d, proxy, sdkConfig, false)
 	if diagErr != nil {
 		return diagErr
 	}
@@ -231,7 +244,7 @@ func updateUser(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 		return diagErr
 	}
 
-	diagErr = executeAllUpdates(d, proxy, sdkConfig, true)
+	diagErr = executeAllUpdates(ctx, d, proxy, sdkConfig, true)
 	if diagErr != nil {
 		return diagErr
 	}
@@ -247,7 +260,7 @@ func deleteUser(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 	email := d.Get("email").(string)
 
 	log.Printf("Deleting user %s", email)
-	err := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+	err := util.RetryWhen(ctx, util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 		// Directory occasionally returns version errors on deletes if an object was updated at the same time.
 		_, proxyDelResponse, err := proxy.deleteUser(ctx, d.Id())
 		if err != nil {
@@ -413,7 +413,8 @@ func DataSourceUser() *schema.Resource {
 
 func UserExporter() *resourceExporter.ResourceExporter {
 	return &resourceExporter.ResourceExporter{
-		GetResourcesFunc: provider.GetAllWithPooledClient(GetAllUsers),
+		GetResourcesFunc:    provider.GetAllWithPooledClient(GetAllUsers),
+		LookupReferenceFunc: provider.LookupReferenceWithPooledClient(lookupUserReference),
 		RefAttrs: map[string]*resourceExporter.RefAttrSettings{
 			"manager":                       {RefType: resourceName},
 			"division_id":                   {RefType: "genesyscloud_auth_division"},
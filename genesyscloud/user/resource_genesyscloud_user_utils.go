@@ -72,7 +72,7 @@ func buildSdkAddresses(d *schema.ResourceData) (*[]platformclientv2.Contact, dia
 }
 
 func executeUpdateUser(ctx context.Context, d *schema.ResourceData, proxy *userProxy, updateUser platformclientv2.Updateuser) diag.Diagnostics {
-	return util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+	return util.RetryWhen(ctx, util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 		currentUser, proxyResponse, errGet := proxy.getUserById(ctx, d.Id(), nil, "")
 		if errGet != nil {
 			return proxyResponse, util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to read user %s error: %s", d.Id(), errGet), proxyResponse)
@@ -88,7 +88,7 @@ func executeUpdateUser(ctx context.Context, d *schema.ResourceData, proxy *userP
 	})
 }
 
-func executeAllUpdates(d *schema.ResourceData, proxy *userProxy, sdkConfig *platformclientv2.Configuration, updateObjectDivision bool) diag.Diagnostics {
+func executeAllUpdates(ctx context.Context, d *schema.ResourceData, proxy *userProxy, sdkConfig *platformclientv2.Configuration, updateObjectDivision bool) diag.Diagnostics {
 
 	if updateObjectDivision {
 		diagErr := util.UpdateObjectDivision(d, "USER", sdkConfig)
@@ -97,17 +97,17 @@ func executeAllUpdates(d *schema.ResourceData, proxy *userProxy, sdkConfig *plat
 		}
 	}
 
-	diagErr := updateUserSkills(d, proxy)
+	diagErr := updateUserSkills(ctx, d, proxy)
 	if diagErr != nil {
 		return diagErr
 	}
 
-	diagErr = updateUserLanguages(d, proxy)
+	diagErr = updateUserLanguages(ctx, d, proxy)
 	if diagErr != nil {
 		return diagErr
 	}
 
-	diagErr = updateUserProfileSkills(d, proxy)
+	diagErr = updateUserProfileSkills(ctx, d, proxy)
 	if diagErr != nil {
 		return diagErr
 	}
@@ -120,7 +120,7 @@ func executeAllUpdates(d *schema.ResourceData, proxy *userProxy, sdkConfig *plat
 	return nil
 }
 
-func updateUserSkills(d *schema.ResourceData, proxy *userProxy) diag.Diagnostics {
+func updateUserSkills(ctx context.Context, d *schema.ResourceData, proxy *userProxy) diag.Diagnostics {
 	transformFunc := func(configSkill interface{}) platformclientv2.Userroutingskillpost {
 		skillMap := configSkill.(map[string]interface{})
 		skillID := skillMap["skill_id"].(string)
@@ -133,7 +133,7 @@ func updateUserSkills(d *schema.ResourceData, proxy *userProxy) diag.Diagnostics
 	}
 
 	chunkProcessor := func(chunk []platformclientv2.Userroutingskillpost) diag.Diagnostics {
-		diagErr := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		diagErr := util.RetryWhen(ctx, util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 			_, resp, err := proxy.userApi.PatchUserRoutingskillsBulk(d.Id(), chunk)
 			if err != nil {
 				return resp, util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to update skills for user %s error: %s", d.Id(), err), resp)
@@ -156,7 +156,7 @@ func updateUserSkills(d *schema.ResourceData, proxy *userProxy) diag.Diagnostics
 	return nil
 }
 
-func updateUserLanguages(d *schema.ResourceData, proxy *userProxy) diag.Diagnostics {
+func updateUserLanguages(ctx context.Context, d *schema.ResourceData, proxy *userProxy) diag.Diagnostics {
 	if d.HasChange("routing_languages") {
 		if languages := d.Get("routing_languages"); languages != nil {
 			log.Printf("Updating languages for user %s", d.Get("email"))
@@ -184,7 +184,7 @@ func updateUserLanguages(d *schema.ResourceData, proxy *userProxy) diag.Diagnost
 			if len(oldLangIds) > 0 {
 				langsToRemove := lists.SliceDifference(oldLangIds, newLangIds)
 				for _, langID := range langsToRemove {
-					diagErr := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+					diagErr := util.RetryWhen(ctx, util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 						resp, err := proxy.userApi.DeleteUserRoutinglanguage(d.Id(), langID)
 						if err != nil {
 							return resp, util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to remove language from user %s error: %s", d.Id(), err), resp)
@@ -209,7 +209,7 @@ func updateUserLanguages(d *schema.ResourceData, proxy *userProxy) diag.Diagnost
 						}
 					}
 				}
-				if diagErr := updateUserRoutingLanguages(d.Id(), langsToAddOrUpdate, newLangProfs, proxy); diagErr != nil {
+				if diagErr := updateUserRoutingLanguages(ctx, d.Id(), langsToAddOrUpdate, newLangProfs, proxy); diagErr != nil {
 					return diagErr
 				}
 			}
@@ -219,11 +219,11 @@ func updateUserLanguages(d *schema.ResourceData, proxy *userProxy) diag.Diagnost
 	return nil
 }
 
-func updateUserProfileSkills(d *schema.ResourceData, proxy *userProxy) diag.Diagnostics {
+func updateUserProfileSkills(ctx context.Context, d *schema.ResourceData, proxy *userProxy) diag.Diagnostics {
 	if d.HasChange("profile_skills") {
 		if profileSkills := d.Get("profile_skills"); profileSkills != nil {
 			profileSkills := lists.SetToStringList(profileSkills.(*schema.Set))
-			diagErr := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+			diagErr := util.RetryWhen(ctx, util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 				_, resp, err := proxy.userApi.PutUserProfileskills(d.Id(), *profileSkills)
 				if err != nil {
 					return resp, util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to update profile skills for user %s error: %s", d.Id(), err), resp)
@@ -289,7 +289,7 @@ func updateUserRoutingUtilization(d *schema.ResourceData, proxy *userProxy) diag
 	return nil
 }
 
-func updateUserRoutingLanguages(userID string, langsToUpdate []string, langProfs map[string]int, proxy *userProxy) diag.Diagnostics {
+func updateUserRoutingLanguages(ctx context.Context, userID string, langsToUpdate []string, langProfs map[string]int, proxy *userProxy) diag.Diagnostics {
 	// Bulk API restricts language adds to 50 per call
 	const maxBatchSize = 50
 
@@ -307,7 +307,7 @@ func updateUserRoutingLanguages(userID string, langsToUpdate []string, langProfs
 	// Closure to process the chunks
 
 	chunkProcessor := func(chunk []platformclientv2.Userroutinglanguagepost) diag.Diagnostics {
-		diagErr := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		diagErr := util.RetryWhen(ctx, util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 			_, resp, err := proxy.userApi.PatchUserRoutinglanguagesBulk(userID, chunk)
 			if err != nil {
 				return resp, util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to update languages for user %s error: %s", userID, err), resp)
@@ -373,7 +373,7 @@ func restoreDeletedUser(ctx context.Context, d *schema.ResourceData, meta interf
 
 	log.Printf("Restoring deleted user %s", email)
 
-	return util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+	return util.RetryWhen(ctx, util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 		currentUser, proxyResp, err := proxy.getUserById(ctx, d.Id(), nil, "deleted")
 		if err != nil {
 			return nil, util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to read user %s error: %s", d.Id(), err), proxyResp)
@@ -13,3 +13,27 @@ func CCToggleExists() bool {
 	_, exists = os.LookupEnv(consistencyCheckerEnvToggle)
 	return exists
 }
+
+const consistencyCheckerReportEnvToggle = "CONSISTENCY_CHECKER_REPORT_FILE"
+
+const consistencyCheckerWarnEnvToggle = "CONSISTENCY_CHECKER_WARN_ONLY"
+
+func CCReportToggleName() string {
+	return consistencyCheckerReportEnvToggle
+}
+
+// CCReportFile returns the path of the JSON Lines file that consistency errors are appended to, if it is set
+func CCReportFile() (string, bool) {
+	path, exists := os.LookupEnv(consistencyCheckerReportEnvToggle)
+	return path, exists && path != ""
+}
+
+func CCWarnToggleName() string {
+	return consistencyCheckerWarnEnvToggle
+}
+
+func CCWarnToggleExists() bool {
+	var exists bool
+	_, exists = os.LookupEnv(consistencyCheckerWarnEnvToggle)
+	return exists
+}
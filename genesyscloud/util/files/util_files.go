@@ -307,3 +307,13 @@ func WriteToFile(bytes []byte, path string) diag.Diagnostics {
 	}
 	return nil
 }
+
+// WriteToFileIfChanged writes the bytes to the file at path unless the file already holds exactly the same content.
+// Leaving unchanged files untouched keeps their modification times, so repeated exports only touch files that changed.
+func WriteToFileIfChanged(bytes []byte, path string) diag.Diagnostics {
+	if existing, err := os.ReadFile(path); err == nil && string(existing) == string(bytes) {
+		log.Printf("File %s is unchanged. Skipping write.", path)
+		return nil
+	}
+	return WriteToFile(bytes, path)
+}
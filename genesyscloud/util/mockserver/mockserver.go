@@ -0,0 +1,219 @@
+package mockserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+/*
+The mockserver package is a local stand-in for the Genesys Cloud API that replays recorded API responses. Pointing the provider
+at it with the base_path provider setting (or the GENESYSCLOUD_BASE_PATH environment variable) lets the acceptance tests run
+offline against the recorded responses of an org.
+
+Fixtures are JSON files with a list of interactions:
+
+	{
+	  "interactions": [
+	    {
+	      "request": {"method": "GET", "path": "/api/v2/users/me"},
+	      "response": {"status": 200, "headers": {"Content-Type": ["application/json"]}, "body": "{\"id\": \"...\"}"}
+	    }
+	  ]
+	}
+
+A request is answered with the first unused interaction with the same method and path, and with the same query parameters when
+the recorded request has any. Interactions are replayed in the order they were recorded, and the last matching interaction is
+replayed again once all of them have been used, so polling a resource keeps returning its last recorded state. Token requests
+without a recorded interaction are answered with a fake access token, and any other request without one with a 404 error.
+*/
+
+// Fixture is the content of a fixture file
+type Fixture struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Interaction is a recorded API request and its response
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is the part of a recorded request that is matched against the requests sent to the server
+type Request struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	Query  string `json:"query,omitempty"`
+}
+
+// Response is a recorded response
+type Response struct {
+	Status  int         `json:"status"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    string      `json:"body,omitempty"`
+}
+
+const tokenPath = "/oauth/token"
+
+// Handler replays recorded interactions
+type Handler struct {
+	mutex        sync.Mutex
+	interactions []*Interaction
+	used         map[*Interaction]bool
+}
+
+// NewHandler returns a handler that replays the interactions
+func NewHandler(interactions []*Interaction) *Handler {
+	return &Handler{
+		interactions: interactions,
+		used:         make(map[*Interaction]bool),
+	}
+}
+
+// LoadFixtures reads the interactions of all fixture files in a directory and its subdirectories, in the order of their paths
+func LoadFixtures(directory string) ([]*Interaction, error) {
+	var paths []string
+	err := filepath.WalkDir(directory, func(path string, entry os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.IsDir() && strings.EqualFold(filepath.Ext(path), ".json") {
+			paths = append(paths, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read fixtures from %s: %v", directory, err)
+	}
+	sort.Strings(paths)
+
+	var interactions []*Interaction
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read fixture %s: %v", path, err)
+		}
+		fixture := &Fixture{}
+		if err := json.Unmarshal(data, fixture); err != nil {
+			return nil, fmt.Errorf("failed to parse fixture %s: %v", path, err)
+		}
+		interactions = append(interactions, fixture.Interactions...)
+	}
+	return interactions, nil
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	interaction := h.match(r)
+	if interaction == nil {
+		if r.Method == http.MethodPost && r.URL.Path == tokenPath {
+			writeJSON(w, http.StatusOK, map[string]interface{}{"access_token": "mockserver-token", "token_type": "bearer", "expires_in": 86400})
+			return
+		}
+		log.Printf("MockServer has no recorded response for %s %s", r.Method, r.URL)
+		writeJSON(w, http.StatusNotFound, map[string]interface{}{
+			"message": fmt.Sprintf("No recorded response for %s %s", r.Method, r.URL.Path),
+			"code":    "not.found",
+			"status":  http.StatusNotFound,
+		})
+		return
+	}
+
+	for name, values := range interaction.Response.Headers {
+		for _, value := range values {
+			w.Header().Add(name, value)
+		}
+	}
+	status := interaction.Response.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	w.WriteHeader(status)
+	_, _ = w.Write([]byte(interaction.Response.Body))
+}
+
+// match returns the next interaction recorded for the request
+func (h *Handler) match(r *http.Request) *Interaction {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	var last *Interaction
+	for _, interaction := range h.interactions {
+		if !interaction.Request.matches(r) {
+			continue
+		}
+		if !h.used[interaction] {
+			h.used[interaction] = true
+			return interaction
+		}
+		last = interaction
+	}
+	return last
+}
+
+func (req *Request) matches(r *http.Request) bool {
+	if !strings.EqualFold(req.Method, r.Method) || strings.TrimSuffix(req.Path, "/") != strings.TrimSuffix(r.URL.Path, "/") {
+		return false
+	}
+	if req.Query == "" {
+		return true
+	}
+	query, err := url.ParseQuery(req.Query)
+	if err != nil {
+		return false
+	}
+	return query.Encode() == r.URL.Query().Encode()
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// Start serves the fixtures of a directory on a port until the server is shut down with ShutDown
+func Start(waitGroup *sync.WaitGroup, directory string, port int) (*http.Server, error) {
+	interactions, err := LoadFixtures(directory)
+	if err != nil {
+		return nil, err
+	}
+	srv := &http.Server{Addr: fmt.Sprintf(":%d", port), Handler: NewHandler(interactions)}
+
+	log.Printf("MockServer started at localhost%s with %d interactions from %s", srv.Addr, len(interactions), directory)
+
+	go func() {
+		defer waitGroup.Done()
+
+		if err := srv.ListenAndServe(); err != http.ErrServerClosed {
+			log.Printf("MockServer ListenAndServe(): %v", err)
+		}
+		log.Println("MockServer finished serving")
+	}()
+
+	return srv, nil
+}
+
+func ShutDown(server *http.Server, waitGroup *sync.WaitGroup) {
+	if err := server.Shutdown(context.TODO()); err != nil {
+		log.Println("Error shutting down server:", err)
+	}
+	waitGroup.Wait()
+}
+
+// NewTestServer serves the fixtures of a directory on a random local port, e.g. from the TestMain of a package. The URL of the
+// server can be used as the base_path of the provider.
+func NewTestServer(directory string) (*httptest.Server, error) {
+	interactions, err := LoadFixtures(directory)
+	if err != nil {
+		return nil, err
+	}
+	return httptest.NewServer(NewHandler(interactions)), nil
+}
@@ -0,0 +1,47 @@
+package mockserver
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// CommandName is the sub command used to run the mock server from the provider binary
+const CommandName = "mockserver"
+
+// RunCommand serves the fixtures of a directory until the command is interrupted, e.g.
+// "terraform-provider-genesyscloud mockserver -fixtures ./fixtures -port 8089". The acceptance tests can then be run against
+// the server by setting GENESYSCLOUD_BASE_PATH to http://localhost:8089.
+func RunCommand(ctx context.Context, _ string, args []string) diag.Diagnostics {
+	var directory string
+	var port int
+	flagSet := flag.NewFlagSet(CommandName, flag.ContinueOnError)
+	flagSet.StringVar(&directory, "fixtures", "./fixtures", "Directory of the fixture files to replay.")
+	flagSet.IntVar(&port, "port", 8089, "Port to serve the fixtures on.")
+	if err := flagSet.Parse(args); err != nil {
+		return diag.FromErr(err)
+	}
+	if flagSet.NArg() > 0 {
+		return diag.Errorf("unexpected arguments for the %s command: %v", CommandName, flagSet.Args())
+	}
+
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
+	defer stop()
+
+	waitGroup := &sync.WaitGroup{}
+	waitGroup.Add(1)
+	server, err := Start(waitGroup, directory, port)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	<-ctx.Done()
+	log.Println("Shutting down MockServer")
+	ShutDown(server, waitGroup)
+	return nil
+}
@@ -0,0 +1,66 @@
+package mockserver
+
+import (
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testFixture = `{
+  "interactions": [
+    {"request": {"method": "GET", "path": "/api/v2/users/1"}, "response": {"status": 200, "body": "{\"state\": \"inactive\"}"}},
+    {"request": {"method": "GET", "path": "/api/v2/users/1"}, "response": {"status": 200, "body": "{\"state\": \"active\"}"}},
+    {"request": {"method": "GET", "path": "/api/v2/users", "query": "pageSize=100&pageNumber=1"}, "response": {"status": 200, "headers": {"Content-Type": ["application/json"]}, "body": "{\"entities\": []}"}}
+  ]
+}`
+
+// TestUnitMockServerReplay verifies recorded responses are replayed in order and unrecorded requests get a token or a 404
+func TestUnitMockServerReplay(t *testing.T) {
+	directory := t.TempDir()
+	if err := os.WriteFile(filepath.Join(directory, "users.json"), []byte(testFixture), 0600); err != nil {
+		t.Fatal(err)
+	}
+	server, err := NewTestServer(directory)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Close()
+
+	get := func(method string, path string) (int, string) {
+		request, err := http.NewRequest(method, server.URL+path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		response, err := http.DefaultClient.Do(request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer response.Body.Close()
+		body, _ := io.ReadAll(response.Body)
+		return response.StatusCode, strings.TrimSpace(string(body))
+	}
+
+	_, body := get(http.MethodGet, "/api/v2/users/1")
+	assert.Equal(t, `{"state": "inactive"}`, body)
+	_, body = get(http.MethodGet, "/api/v2/users/1")
+	assert.Equal(t, `{"state": "active"}`, body)
+	_, body = get(http.MethodGet, "/api/v2/users/1")
+	assert.Equal(t, `{"state": "active"}`, body)
+
+	status, body := get(http.MethodGet, "/api/v2/users?pageNumber=1&pageSize=100")
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, `{"entities": []}`, body)
+	status, _ = get(http.MethodGet, "/api/v2/users?pageNumber=2&pageSize=100")
+	assert.Equal(t, http.StatusNotFound, status)
+
+	status, body = get(http.MethodPost, "/oauth/token")
+	assert.Equal(t, http.StatusOK, status)
+	assert.Contains(t, body, "access_token")
+	status, _ = get(http.MethodDelete, "/api/v2/users/1")
+	assert.Equal(t, http.StatusNotFound, status)
+}
@@ -0,0 +1,326 @@
+package recorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util/mockserver"
+
+	"github.com/google/uuid"
+	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
+)
+
+/*
+The recorder package records the API interactions of a test run to a cassette file once, so that later test runs can replay them
+without a Genesys Cloud org or OAuth credentials. Cassettes use the fixture format of the mockserver package. Access tokens, secrets
+and personal data in the request paths, queries and responses are scrubbed before a cassette is written, see scrub.go.
+
+The mode is set with the GENESYSCLOUD_RECORDER_MODE environment variable:
+
+	record - requests are sent to the org of the GENESYSCLOUD_REGION or GENESYSCLOUD_BASE_PATH and written to the cassette
+	replay - requests are answered from the cassette
+
+The SDK doesn't allow the HTTP client of a client config to be replaced, so the recording transport is served on a local address and
+injected into client configs through their base path. Start points GENESYSCLOUD_BASE_PATH at the local address, which is used by the
+provider and by provider.AuthorizeSdk, and Inject does the same for client configs created by a test. Files are uploaded to presigned
+URLs with the default HTTP transport, so Start also routes the requests of the default transport through the recording transport,
+except those of the acceptance test framework to HashiCorp hosts.
+
+Recorded requests are replayed in order, so the random names generated by the tests with uuid.NewString must be the same in every
+run. Start seeds the random source of the uuid package for that reason. Tests that run in parallel can still send their requests in
+a different order than they were recorded in, which only matters when they send the same requests.
+*/
+
+const (
+	ModeEnvVar = "GENESYSCLOUD_RECORDER_MODE"
+	ModeRecord = "record"
+	ModeReplay = "replay"
+
+	// The seed of the uuid random source, so replayed tests generate the same names as recorded tests
+	uuidSeed = 1
+)
+
+const tokenPath = "/oauth/token"
+
+// Transport is an http.RoundTripper that records the interactions with the API to a cassette or replays them from a cassette
+type Transport struct {
+	mode         string
+	cassettePath string
+	basePath     string
+	authBasePath string
+	upstream     http.RoundTripper
+
+	mutex        sync.Mutex
+	interactions []*mockserver.Interaction
+	requestData  []string
+	replay       *mockserver.Handler
+}
+
+// NewTransport returns a transport for a cassette. In record mode, requests are sent to the base path, or to the auth base path
+// for token requests. In replay mode, the interactions of the cassette are loaded.
+func NewTransport(mode string, cassettePath string, basePath string, authBasePath string) (*Transport, error) {
+	t := &Transport{
+		mode:         mode,
+		cassettePath: cassettePath,
+		basePath:     strings.TrimSuffix(basePath, "/"),
+		authBasePath: strings.TrimSuffix(authBasePath, "/"),
+		upstream:     http.DefaultTransport,
+	}
+
+	switch mode {
+	case ModeRecord:
+	case ModeReplay:
+		data, err := os.ReadFile(cassettePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read cassette %s: %v", cassettePath, err)
+		}
+		cassette := &mockserver.Fixture{}
+		if err := json.Unmarshal(data, cassette); err != nil {
+			return nil, fmt.Errorf("failed to parse cassette %s: %v", cassettePath, err)
+		}
+		t.replay = mockserver.NewHandler(cassette.Interactions)
+	default:
+		return nil, fmt.Errorf("unknown recorder mode %q, expected %s or %s", mode, ModeRecord, ModeReplay)
+	}
+	return t, nil
+}
+
+func (t *Transport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if t.mode == ModeReplay {
+		recorder := httptest.NewRecorder()
+		t.replay.ServeHTTP(recorder, r)
+		response := recorder.Result()
+		response.Request = r
+		return response, nil
+	}
+	return t.record(r)
+}
+
+// record sends the request upstream and records it along with its response. Requests to the local address of the recorder have
+// a relative URL and are sent to the base path, other requests are sent to their own URL.
+func (t *Transport) record(r *http.Request) (*http.Response, error) {
+	var requestBody []byte
+	if r.Body != nil {
+		var err error
+		if requestBody, err = io.ReadAll(r.Body); err != nil {
+			return nil, err
+		}
+		_ = r.Body.Close()
+	}
+
+	upstreamURL := r.URL
+	if !r.URL.IsAbs() {
+		target := t.basePath
+		if r.URL.Path == tokenPath {
+			target = t.authBasePath
+		}
+		var err error
+		if upstreamURL, err = url.Parse(target + r.URL.Path); err != nil {
+			return nil, err
+		}
+		upstreamURL.RawQuery = r.URL.RawQuery
+	}
+
+	upstreamRequest := r.Clone(r.Context())
+	upstreamRequest.URL = upstreamURL
+	upstreamRequest.Host = upstreamURL.Host
+	upstreamRequest.RequestURI = ""
+	upstreamRequest.Body = io.NopCloser(bytes.NewReader(requestBody))
+	upstreamRequest.ContentLength = int64(len(requestBody))
+	// Let the transport negotiate the encoding, so the recorded response bodies are not compressed
+	upstreamRequest.Header.Del("Accept-Encoding")
+
+	response, err := t.upstream.RoundTrip(upstreamRequest)
+	if err != nil {
+		return nil, err
+	}
+	responseBody, err := io.ReadAll(response.Body)
+	_ = response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	response.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.interactions = append(t.interactions, &mockserver.Interaction{
+		Request: mockserver.Request{
+			Method: r.Method,
+			Path:   r.URL.Path,
+			Query:  r.URL.RawQuery,
+		},
+		Response: mockserver.Response{
+			Status:  response.StatusCode,
+			Headers: recordedHeaders(response.Header),
+			Body:    string(responseBody),
+		},
+	})
+	t.requestData = append(t.requestData, string(requestBody))
+	return response, nil
+}
+
+// recordedHeaders returns the response headers that are written to cassettes. Other headers, e.g. cookies and correlation IDs,
+// are left out.
+func recordedHeaders(header http.Header) http.Header {
+	recorded := http.Header{}
+	for _, name := range []string{"Content-Type", "Retry-After"} {
+		if values := header.Values(name); len(values) > 0 {
+			recorded[name] = values
+		}
+	}
+	return recorded
+}
+
+// Save writes the scrubbed interactions to the cassette in record mode
+func (t *Transport) Save() error {
+	if t.mode != ModeRecord {
+		return nil
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	scrubber := newScrubber(t.requestData)
+	for _, interaction := range t.interactions {
+		scrubber.scrub(interaction)
+	}
+
+	data, err := json.MarshalIndent(&mockserver.Fixture{Interactions: t.interactions}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(t.cassettePath), os.ModePerm); err != nil {
+		return err
+	}
+	log.Printf("Writing %d recorded interactions to %s", len(t.interactions), t.cassettePath)
+	return os.WriteFile(t.cassettePath, data, 0644)
+}
+
+// Recorder serves a Transport on a local address, so it can be used as the base path of client configs
+type Recorder struct {
+	transport        *Transport
+	server           *httptest.Server
+	env              map[string]*string
+	defaultTransport http.RoundTripper
+}
+
+// defaultTransport routes the requests of the default HTTP transport through the recording transport
+type defaultTransport struct {
+	recording http.RoundTripper
+	fallback  http.RoundTripper
+}
+
+// The hosts of the acceptance test framework, e.g. to install terraform, whose requests are not recorded
+var passthroughHostSuffixes = []string{"hashicorp.com", "terraform.io"}
+
+func (d *defaultTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	for _, suffix := range passthroughHostSuffixes {
+		if strings.HasSuffix(r.URL.Hostname(), suffix) {
+			return d.fallback.RoundTrip(r)
+		}
+	}
+	return d.recording.RoundTrip(r)
+}
+
+// Start starts a recorder for a cassette in the mode of the GENESYSCLOUD_RECORDER_MODE environment variable, and points the
+// GENESYSCLOUD_BASE_PATH of the provider at it. It returns nil when the mode isn't set.
+func Start(cassettePath string) (*Recorder, error) {
+	mode := os.Getenv(ModeEnvVar)
+	if mode == "" {
+		return nil, nil
+	}
+
+	basePath := provider.GetBasePath(os.Getenv("GENESYSCLOUD_REGION"), os.Getenv("GENESYSCLOUD_BASE_PATH"))
+	authBasePath := provider.GetAuthBasePath(basePath, os.Getenv("GENESYSCLOUD_AUTH_BASE_PATH"))
+	transport, err := NewTransport(mode, cassettePath, basePath, authBasePath)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Recorder{transport: transport, env: make(map[string]*string), defaultTransport: http.DefaultTransport}
+	r.server = httptest.NewServer(http.HandlerFunc(r.serveHTTP))
+	r.setEnv("GENESYSCLOUD_BASE_PATH", r.server.URL)
+	r.setEnv("GENESYSCLOUD_AUTH_BASE_PATH", r.server.URL)
+	if mode == ModeReplay {
+		// Replayed tests don't need credentials, but the acceptance test pre-check requires them to be set
+		for _, name := range []string{"GENESYSCLOUD_OAUTHCLIENT_ID", "GENESYSCLOUD_OAUTHCLIENT_SECRET"} {
+			if os.Getenv(name) == "" {
+				r.setEnv(name, "replay")
+			}
+		}
+	}
+	http.DefaultTransport = &defaultTransport{recording: transport, fallback: r.defaultTransport}
+	uuid.SetRand(rand.New(rand.NewSource(uuidSeed)))
+
+	log.Printf("Recorder started in %s mode at %s with cassette %s", mode, r.server.URL, cassettePath)
+	return r, nil
+}
+
+// URL returns the local address of the recorder
+func (r *Recorder) URL() string {
+	return r.server.URL
+}
+
+// Inject points a client config at the recorder
+func (r *Recorder) Inject(config *platformclientv2.Configuration) {
+	if r == nil {
+		return
+	}
+	config.BasePath = r.server.URL
+}
+
+// Stop stops the recorder, writes the cassette in record mode and restores the environment
+func (r *Recorder) Stop() error {
+	if r == nil {
+		return nil
+	}
+	r.server.Close()
+	http.DefaultTransport = r.defaultTransport
+	uuid.SetRand(nil)
+	for name, value := range r.env {
+		if value == nil {
+			_ = os.Unsetenv(name)
+		} else {
+			_ = os.Setenv(name, *value)
+		}
+	}
+	return r.transport.Save()
+}
+
+func (r *Recorder) setEnv(name string, value string) {
+	if previous, ok := os.LookupEnv(name); ok {
+		r.env[name] = &previous
+	} else {
+		r.env[name] = nil
+	}
+	_ = os.Setenv(name, value)
+}
+
+func (r *Recorder) serveHTTP(w http.ResponseWriter, req *http.Request) {
+	response, err := r.transport.RoundTrip(req)
+	if err != nil {
+		log.Printf("Recorder failed to send %s %s: %v", req.Method, req.URL, err)
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer response.Body.Close()
+
+	for name, values := range response.Header {
+		for _, value := range values {
+			w.Header().Add(name, value)
+		}
+	}
+	w.WriteHeader(response.StatusCode)
+	_, _ = io.Copy(w, response.Body)
+}
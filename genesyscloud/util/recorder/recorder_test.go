@@ -0,0 +1,82 @@
+package recorder
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestUnitRecorderRecordAndReplay verifies recorded interactions are scrubbed and replayed without the upstream API
+func TestUnitRecorderRecordAndReplay(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "session=secret")
+		switch r.URL.Path {
+		case "/oauth/token":
+			_, _ = w.Write([]byte(`{"access_token": "real-token", "expires_in": 86400}`))
+		case "/api/v2/users":
+			body, _ := io.ReadAll(r.Body)
+			_, _ = w.Write([]byte(`{"id": "1", "request": ` + string(body) + `, "manager": {"email": "jane.doe@genesys.com", "phone": "+13175551234"}}`))
+		}
+	}))
+	defer upstream.Close()
+
+	cassettePath := filepath.Join(t.TempDir(), "cassettes", "users.json")
+	send := func(transport *Transport, method string, path string, body string) string {
+		request := httptest.NewRequest(method, path, strings.NewReader(body))
+		response, err := transport.RoundTrip(request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer response.Body.Close()
+		data, _ := io.ReadAll(response.Body)
+		return string(data)
+	}
+
+	recording, err := NewTransport(ModeRecord, cassettePath, upstream.URL, upstream.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Contains(t, send(recording, http.MethodPost, "/oauth/token", "grant_type=client_credentials"), "real-token")
+	send(recording, http.MethodPost, "/api/v2/users", `{"email": "test@user.com"}`)
+	send(recording, http.MethodGet, "/api/v2/users/jane.doe@genesys.com?email=jane.doe%40genesys.com&client_secret=query-secret", "")
+	if err := recording.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	cassette, err := os.ReadFile(cassettePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NotContains(t, string(cassette), "real-token")
+	assert.NotContains(t, string(cassette), "session=secret")
+	assert.NotContains(t, string(cassette), "jane.doe")
+	assert.NotContains(t, string(cassette), "query-secret")
+	assert.NotContains(t, string(cassette), "+13175551234")
+	assert.Contains(t, string(cassette), "test@user.com")
+
+	upstream.Close()
+	replaying, err := NewTransport(ModeReplay, cassettePath, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Contains(t, send(replaying, http.MethodPost, "/oauth/token", ""), redacted)
+	body := send(replaying, http.MethodPost, "/api/v2/users", `{"email": "test@user.com"}`)
+	assert.Contains(t, body, "test@user.com")
+	assert.Contains(t, body, "@example.com")
+
+	// Requests for the scrubbed data of the org are replayed with its placeholder
+	placeholder := "user-" + hashOf("jane.doe@genesys.com")[:8] + "@example.com"
+	response, err := replaying.RoundTrip(httptest.NewRequest(http.MethodGet, "/api/v2/users/"+placeholder+"?email="+url.QueryEscape(placeholder)+"&client_secret="+redacted, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+}
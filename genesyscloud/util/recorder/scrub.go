@@ -0,0 +1,128 @@
+package recorder
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"terraform-provider-genesyscloud/genesyscloud/util/mockserver"
+)
+
+const redacted = "REDACTED"
+
+// The JSON fields of response bodies whose values are always redacted
+var secretFields = map[string]bool{
+	"access_token":  true,
+	"refresh_token": true,
+	"id_token":      true,
+	"token":         true,
+	"password":      true,
+	"secret":        true,
+	"client_secret": true,
+	"clientsecret":  true,
+	"authorization": true,
+}
+
+var (
+	emailRegex = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+	phoneRegex = regexp.MustCompile(`\+[1-9][0-9]{7,14}`)
+)
+
+// scrubber removes secrets and personal data from recorded interactions. Email addresses and phone numbers that were sent by the
+// tests in request bodies are test data and are kept, so replayed responses still match the test configs. Any other email address
+// or phone number belongs to the org and is replaced with a placeholder derived from its hash, so the same value is always replaced
+// the same way. Request paths and queries are scrubbed like response bodies, so a value of the org that a test reads from a response
+// and sends in a later request is replaced with the same placeholder in both.
+type scrubber struct {
+	requestData string
+}
+
+func newScrubber(requestData []string) *scrubber {
+	return &scrubber{requestData: strings.Join(requestData, "\n")}
+}
+
+func (s *scrubber) scrub(interaction *mockserver.Interaction) {
+	interaction.Request.Path = s.scrubPersonalData(interaction.Request.Path)
+	interaction.Request.Query = s.scrubQuery(interaction.Request.Query)
+
+	body := interaction.Response.Body
+	var value interface{}
+	decoder := json.NewDecoder(strings.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err == nil {
+		if data, err := json.Marshal(redactSecrets(value)); err == nil {
+			body = string(data)
+		}
+	}
+	interaction.Response.Body = s.scrubPersonalData(body)
+}
+
+// scrubQuery redacts the values of secret query parameters and scrubs the personal data of the others
+func (s *scrubber) scrubQuery(rawQuery string) string {
+	if rawQuery == "" {
+		return rawQuery
+	}
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return s.scrubPersonalData(rawQuery)
+	}
+	for key, values := range query {
+		for i, value := range values {
+			if secretFields[strings.ToLower(key)] {
+				values[i] = redacted
+			} else {
+				values[i] = s.scrubPersonalData(value)
+			}
+		}
+	}
+	return query.Encode()
+}
+
+// scrubPersonalData replaces the email addresses and phone numbers that were not sent by the tests
+func (s *scrubber) scrubPersonalData(value string) string {
+	value = emailRegex.ReplaceAllStringFunc(value, func(email string) string {
+		if s.sentByTest(email) {
+			return email
+		}
+		return fmt.Sprintf("user-%s@example.com", hashOf(email)[:8])
+	})
+	return phoneRegex.ReplaceAllStringFunc(value, func(phone string) string {
+		if s.sentByTest(phone) {
+			return phone
+		}
+		number := new(big.Int)
+		number.SetString(hashOf(phone)[:12], 16)
+		return fmt.Sprintf("+1555%07d", number.Int64()%10000000)
+	})
+}
+
+func (s *scrubber) sentByTest(value string) bool {
+	return strings.Contains(s.requestData, value)
+}
+
+// redactSecrets replaces the values of secret fields in a decoded JSON value
+func redactSecrets(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, fieldValue := range v {
+			if secretFields[strings.ToLower(key)] {
+				v[key] = redacted
+			} else {
+				v[key] = redactSecrets(fieldValue)
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactSecrets(item)
+		}
+	}
+	return value
+}
+
+func hashOf(value string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(value)))
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 
 	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -27,8 +28,9 @@ func WithRetries(ctx context.Context, timeout time.Duration, method func() *retr
 	return err
 }
 
+// WithRetriesForRead retries a read for the read after write timeout of the retry policy, 5 minutes by default
 func WithRetriesForRead(ctx context.Context, d *schema.ResourceData, method func() *retry.RetryError) diag.Diagnostics {
-	return WithRetriesForReadCustomTimeout(ctx, 5*time.Minute, d, method)
+	return WithRetriesForReadCustomTimeout(ctx, provider.RetryPolicyFromContext(ctx).ReadTimeout(5*time.Minute), d, method)
 }
 
 func WithRetriesForReadCustomTimeout(ctx context.Context, timeout time.Duration, d *schema.ResourceData, method func() *retry.RetryError) diag.Diagnostics {
@@ -39,8 +41,9 @@ func WithRetriesForReadCustomTimeout(ctx context.Context, timeout time.Duration,
 			d.SetId("")
 		}
 		errStringLower := strings.ToLower(fmt.Sprintf("%v", err))
-		if strings.Contains(errStringLower, "timeout while waiting for state to become") ||
-			strings.Contains(errStringLower, "context deadline exceeded") {
+		// The read is restarted while it times out, unless the retry policy sets how long it may take
+		if provider.RetryPolicyFromContext(ctx).ReadAfterWriteTimeout == 0 &&
+			(strings.Contains(errStringLower, "timeout while waiting for state to become") || strings.Contains(errStringLower, "context deadline exceeded")) {
 			ctx, cancel := context.WithTimeout(context.Background(), timeout)
 			defer cancel()
 			return WithRetriesForRead(ctx, d, method)
@@ -55,17 +58,19 @@ func WithRetriesForReadCustomTimeout(ctx context.Context, timeout time.Duration,
 type checkResponseFunc func(resp *platformclientv2.APIResponse, additionalCodes ...int) bool
 type callSdkFunc func() (*platformclientv2.APIResponse, diag.Diagnostics)
 
-// Retries up to 10 times while the shouldRetry condition returns true
+// Retries up to 10 times, or the max attempts of the retry policy carried by the context, while the shouldRetry condition returns
+// true or the status code is retryable in the retry policy
 // Useful for adding custom retry logic to normally non-retryable error codes
-func RetryWhen(shouldRetry checkResponseFunc, callSdk callSdkFunc, additionalCodes ...int) diag.Diagnostics {
+func RetryWhen(ctx context.Context, shouldRetry checkResponseFunc, callSdk callSdkFunc, additionalCodes ...int) diag.Diagnostics {
 	var lastErr diag.Diagnostics
-	for i := 0; i < 10; i++ {
+	retryPolicy := provider.RetryPolicyFromContext(ctx)
+	for i := 0; i < retryPolicy.Attempts(10); i++ {
 		resp, sdkErr := callSdk()
 		if sdkErr != nil {
-			if resp != nil && shouldRetry(resp, additionalCodes...) {
-				// Wait a second and try again
+			if resp != nil && (shouldRetry(resp, additionalCodes...) || retryPolicy.IsRetryableStatus(resp.StatusCode)) {
+				// Wait a second, or the backoff of the retry policy, and try again
 				lastErr = sdkErr
-				time.Sleep(time.Second)
+				time.Sleep(retryPolicy.Backoff(i+1, time.Second, time.Second))
 				continue
 			} else {
 				return sdkErr
@@ -0,0 +1,69 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestUnitRetryWhenRetryPolicy verifies RetryWhen honours the max attempts and retryable status codes of the retry policy
+func TestUnitRetryWhenRetryPolicy(t *testing.T) {
+	ctx := provider.WithRetryPolicy(context.Background(), &provider.RetryPolicy{
+		MaxAttempts:          3,
+		BackoffBase:          time.Millisecond,
+		RetryableStatusCodes: []int{http.StatusNotFound},
+	})
+
+	calls := 0
+	diagErr := RetryWhen(ctx, IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		calls++
+		return &platformclientv2.APIResponse{StatusCode: http.StatusNotFound}, diag.Errorf("not found")
+	})
+	assert.NotNil(t, diagErr)
+	assert.Equal(t, 3, calls)
+
+	calls = 0
+	diagErr = RetryWhen(ctx, IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		calls++
+		if calls == 1 {
+			return &platformclientv2.APIResponse{StatusCode: http.StatusNotFound}, diag.Errorf("not found")
+		}
+		return &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	})
+	assert.Nil(t, diagErr)
+	assert.Equal(t, 2, calls)
+}
+
+// TestUnitWithRetriesForReadTimeout verifies reads are only restarted on timeouts when the retry policy doesn't set a read timeout
+func TestUnitWithRetriesForReadTimeout(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+	d.SetId("id")
+
+	calls := 0
+	timeout := func() *retry.RetryError {
+		calls++
+		if calls == 1 {
+			return retry.NonRetryableError(fmt.Errorf("context deadline exceeded"))
+		}
+		return nil
+	}
+
+	ctx := provider.WithRetryPolicy(context.Background(), &provider.RetryPolicy{ReadAfterWriteTimeout: time.Second})
+	diagErr := WithRetriesForReadCustomTimeout(ctx, time.Second, d, timeout)
+	assert.NotNil(t, diagErr)
+	assert.Equal(t, 1, calls)
+
+	calls = 0
+	diagErr = WithRetriesForReadCustomTimeout(context.Background(), time.Second, d, timeout)
+	assert.Nil(t, diagErr)
+	assert.Equal(t, 2, calls)
+}
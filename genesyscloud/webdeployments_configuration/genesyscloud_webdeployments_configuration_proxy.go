@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	"terraform-provider-genesyscloud/genesyscloud/util"
 	"time"
 
@@ -42,10 +43,10 @@ func newWebDeploymentsConfigurationProxy(clientConfig *platformclientv2.Configur
 }
 
 func getWebDeploymentConfigurationsProxy(clientConfig *platformclientv2.Configuration) *webDeploymentsConfigurationProxy {
-	if internalProxy == nil {
-		internalProxy = newWebDeploymentsConfigurationProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return provider.GetProxy(clientConfig, newWebDeploymentsConfigurationProxy)
 }
 
 type webDeploymentsConfigurationProxy struct {
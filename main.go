@@ -1,7 +1,10 @@
 package main
 
 import (
+	"context"
 	"flag"
+	"log"
+	"os"
 	"sync"
 	gcloud "terraform-provider-genesyscloud/genesyscloud"
 	dt "terraform-provider-genesyscloud/genesyscloud/architect_datatable"
@@ -20,6 +23,7 @@ import (
 	cMessageSettings "terraform-provider-genesyscloud/genesyscloud/conversations_messaging_settings"
 	cMessageSettingsDefault "terraform-provider-genesyscloud/genesyscloud/conversations_messaging_settings_default"
 	supportedContent "terraform-provider-genesyscloud/genesyscloud/conversations_messaging_supportedcontent"
+	dependentConsumers "terraform-provider-genesyscloud/genesyscloud/dependent_consumers"
 	employeeperformanceExternalmetricsDefinition "terraform-provider-genesyscloud/genesyscloud/employeeperformance_externalmetrics_definitions"
 	externalContacts "terraform-provider-genesyscloud/genesyscloud/external_contacts"
 	flowLogLevel "terraform-provider-genesyscloud/genesyscloud/flow_loglevel"
@@ -105,9 +109,11 @@ import (
 	tfexp "terraform-provider-genesyscloud/genesyscloud/tfexporter"
 	"terraform-provider-genesyscloud/genesyscloud/user"
 	userRoles "terraform-provider-genesyscloud/genesyscloud/user_roles"
+	"terraform-provider-genesyscloud/genesyscloud/util/mockserver"
 	webDeployConfig "terraform-provider-genesyscloud/genesyscloud/webdeployments_configuration"
 	webDeployDeploy "terraform-provider-genesyscloud/genesyscloud/webdeployments_deployment"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 )
@@ -140,15 +146,41 @@ var resourceExporters map[string]*resourceExporter.ResourceExporter
 func main() {
 	var debugMode bool
 
-	flag.BoolVar(&debugMode, "debug", false, "set to true to run the provider with support for debuggers like delve")
-	flag.Parse()
-
 	providerResources = make(map[string]*schema.Resource)
 	providerDataSources = make(map[string]*schema.Resource)
 	resourceExporters = make(map[string]*resourceExporter.ResourceExporter)
 
 	registerResources()
 
+	// Run the exporter (e.g. "terraform-provider-genesyscloud export -export-as-hcl") or the drift report
+	// (e.g. "terraform-provider-genesyscloud drift -state terraform.tfstate") directly instead of serving the provider.
+	// The mock server (e.g. "terraform-provider-genesyscloud mockserver -fixtures ./fixtures") replays recorded API responses.
+	if len(os.Args) > 1 {
+		commands := map[string]func(context.Context, string, []string) diag.Diagnostics{
+			tfexp.ExportCommandName: tfexp.RunExportCommand,
+			tfexp.DriftCommandName:  tfexp.RunDriftCommand,
+			mockserver.CommandName:  mockserver.RunCommand,
+		}
+		if command, ok := commands[os.Args[1]]; ok {
+			diagErr := command(context.Background(), version, os.Args[2:])
+			writeAPITelemetryReport()
+			for _, d := range diagErr {
+				if d.Severity == diag.Warning {
+					log.Printf("%s warning: %s %s", os.Args[1], d.Summary, d.Detail)
+				} else {
+					log.Printf("%s failed: %s %s", os.Args[1], d.Summary, d.Detail)
+				}
+			}
+			if diagErr.HasError() {
+				os.Exit(1)
+			}
+			return
+		}
+	}
+
+	flag.BoolVar(&debugMode, "debug", false, "set to true to run the provider with support for debuggers like delve")
+	flag.Parse()
+
 	opts := &plugin.ServeOpts{ProviderFunc: provider.New(version, providerResources, providerDataSources)}
 
 	if debugMode {
@@ -156,6 +188,14 @@ func main() {
 		opts.ProviderAddr = "genesys.com/mypurecloud/genesyscloud"
 	}
 	plugin.Serve(opts)
+	writeAPITelemetryReport()
+}
+
+// writeAPITelemetryReport writes the final API telemetry report when the api_telemetry_file provider setting is set
+func writeAPITelemetryReport() {
+	if err := provider.WriteAPITelemetryReport(); err != nil {
+		log.Printf("Failed to write API telemetry report: %v", err)
+	}
 }
 
 type RegisterInstance struct {
@@ -267,6 +307,7 @@ func registerResources() {
 	routingSkillGroup.SetRegistrar(regInstance)                            //Registering routing skill group
 	cMessageSettingsDefault.SetRegistrar(regInstance)                      //Registering conversations messaging settings default
 	location.SetRegistrar(regInstance)                                     //Registering location
+	dependentConsumers.SetRegistrar(regInstance)                           //Registering dependency consumers
 	// setting resources for Use cases  like TF export where provider is used in resource classes.
 	tfexp.SetRegistrar(regInstance) //Registering tf exporter
 	registrar.SetResources(providerResources, providerDataSources)